@@ -0,0 +1,236 @@
+package mercadopago
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Amount is a money amount stored as a fixed-point number with 6 decimals,
+// so it can be added and compared without the rounding errors of floats.
+// It is encoded as a JSON number and decoded from JSON numbers or strings.
+// Operations whose result does not fit in an Amount panic with ErrAmountOverflow.
+type Amount int64
+
+// ErrAmountOverflow is the panic value of amount operations out of range
+var ErrAmountOverflow = errors.New("mercadopago: amount overflow")
+
+// amountPattern accepts plain decimal numbers, as written in JSON
+var amountPattern = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]{1,3})?$`)
+
+const (
+	amountDecimals       = 6
+	amountScale    int64 = 1000000
+)
+
+// currencyDecimals lists the currencies whose minor unit is not the cent
+var currencyDecimals = map[string]int{
+	"CLP": 0,
+	"PYG": 0,
+}
+
+// CurrencyDecimals returns the number of decimals used by a currency (e.g. 2 for ARS, 0 for CLP)
+func CurrencyDecimals(currencyID string) int {
+	if d, ok := currencyDecimals[strings.ToUpper(currencyID)]; ok {
+		return d
+	}
+	return 2
+}
+
+// NewAmount returns the amount value * 10^-decimals, e.g. NewAmount(1020, 2) is 10.20
+func NewAmount(value int64, decimals int) Amount {
+	if decimals > amountDecimals {
+		return Amount(roundDiv(value, pow10(decimals-amountDecimals)))
+	}
+	return Amount(mul(value, pow10(amountDecimals-decimals)))
+}
+
+// AmountFromMinorUnits returns the amount of minor units (e.g. cents) of a currency
+func AmountFromMinorUnits(minor int64, currencyID string) Amount {
+	return NewAmount(minor, CurrencyDecimals(currencyID))
+}
+
+// ParseAmount parses a decimal number such as "10.20", rounding it to 6 decimals
+func ParseAmount(s string) (Amount, error) {
+	if !amountPattern.MatchString(s) {
+		return 0, fmt.Errorf("mercadopago: invalid amount %q", s)
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return 0, fmt.Errorf("mercadopago: invalid amount %q", s)
+	}
+	num := new(big.Int).Mul(r.Num(), big.NewInt(amountScale))
+	den := r.Denom()
+	q, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	// Round half away from zero
+	if rem.Sign() != 0 && new(big.Int).Abs(new(big.Int).Lsh(rem, 1)).Cmp(den) >= 0 {
+		q.Add(q, big.NewInt(int64(num.Sign())))
+	}
+	if !q.IsInt64() {
+		return 0, fmt.Errorf("mercadopago: amount %q out of range", s)
+	}
+	return Amount(q.Int64()), nil
+}
+
+// MustParseAmount is like ParseAmount but panics if the amount is not valid
+func MustParseAmount(s string) Amount {
+	a, err := ParseAmount(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Add returns a + b
+func (a Amount) Add(b Amount) Amount {
+	s := a + b
+	if (b > 0 && s < a) || (b < 0 && s > a) {
+		panic(ErrAmountOverflow)
+	}
+	return s
+}
+
+// Sub returns a - b
+func (a Amount) Sub(b Amount) Amount {
+	d := a - b
+	if (b > 0 && d > a) || (b < 0 && d < a) {
+		panic(ErrAmountOverflow)
+	}
+	return d
+}
+
+// Mul returns a * n, e.g. the unit price times the quantity of an item
+func (a Amount) Mul(n int64) Amount {
+	return Amount(mul(int64(a), n))
+}
+
+// Cmp returns -1, 0 or +1 when a is less than, equal to or greater than b
+func (a Amount) Cmp(b Amount) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// IsZero reports whether the amount is zero
+func (a Amount) IsZero() bool {
+	return a == 0
+}
+
+// Round rounds the amount half away from zero to the decimals of a currency
+func (a Amount) Round(currencyID string) Amount {
+	unit := pow10(amountDecimals - CurrencyDecimals(currencyID))
+	return Amount(mul(roundDiv(int64(a), unit), unit))
+}
+
+// MinorUnits returns the amount rounded to minor units (e.g. cents) of a currency
+func (a Amount) MinorUnits(currencyID string) int64 {
+	return roundDiv(int64(a), pow10(amountDecimals-CurrencyDecimals(currencyID)))
+}
+
+// Format returns the amount rounded and padded to the decimals of a currency, e.g. "10.20"
+func (a Amount) Format(currencyID string) string {
+	decimals := CurrencyDecimals(currencyID)
+	s := a.Round(currencyID).String()
+	if decimals == 0 {
+		return s
+	}
+	if !strings.Contains(s, ".") {
+		s += "."
+	}
+	return s + strings.Repeat("0", decimals-(len(s)-strings.Index(s, ".")-1))
+}
+
+// String returns the amount as decimal number without trailing zeros, e.g. "10.2"
+func (a Amount) String() string {
+	sign := ""
+	v := uint64(a)
+	if a < 0 {
+		sign = "-"
+		v = uint64(-a)
+	}
+	s := sign + strconv.FormatUint(v/uint64(amountScale), 10)
+	frac := strings.TrimRight(fmt.Sprintf("%06d", v%uint64(amountScale)), "0")
+	if frac != "" {
+		s += "." + frac
+	}
+	return s
+}
+
+// Float64 returns the amount as float, which may lose precision
+func (a Amount) Float64() float64 {
+	return float64(a) / float64(amountScale)
+}
+
+// MarshalJSON encodes the amount as a JSON number
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalJSON accepts both JSON numbers and strings
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		*a = 0
+		return nil
+	}
+	if strings.HasPrefix(s, `"`) {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		if s == "" {
+			*a = 0
+			return nil
+		}
+	}
+	v, err := ParseAmount(s)
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+func pow10(n int) int64 {
+	p := int64(1)
+	for i := 0; i < n; i++ {
+		p *= 10
+	}
+	return p
+}
+
+// mul returns a * b, panicking with ErrAmountOverflow when it does not fit in an int64
+func mul(a, b int64) int64 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	c := a * b
+	if c/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		panic(ErrAmountOverflow)
+	}
+	return c
+}
+
+// roundDiv returns n / d rounded half away from zero
+func roundDiv(n, d int64) int64 {
+	q, r := n/d, n%d
+	if r < 0 {
+		r = -r
+	}
+	if 2*r >= d {
+		if n < 0 {
+			q--
+		} else {
+			q++
+		}
+	}
+	return q
+}
@@ -0,0 +1,107 @@
+package mercadopago_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/gpascual2/mp-sdk-go"
+)
+
+// TestAmountJSON - Amounts should be decoded from numbers or strings and encoded exactly
+func TestAmountJSON(t *testing.T) {
+	fmt.Println("mp_test : AmountJSON")
+	var amounts []mercadopago.Amount
+	if err := json.Unmarshal([]byte(`[10.2, "0.1", 1500, null, 3.8333333333, -0.5, 1.25e3]`), &amounts); err != nil {
+		t.Fatalf("Error decoding the amounts: %v", err)
+	}
+	expected := []string{"10.2", "0.1", "1500", "0", "3.833333", "-0.5", "1250"}
+	for i, a := range amounts {
+		if a.String() != expected[i] {
+			t.Errorf("Expected amount %s and got %s", expected[i], a)
+		}
+	}
+	item := mercadopago.Item{Title: "Item1_title", Quantity: 1, UnitPrice: amounts[0]}
+	encoded, _ := json.Marshal(item)
+	if string(encoded) != `{"title":"Item1_title","quantity":1,"unit_price":10.2}` {
+		t.Errorf("Unexpected item encoded: %s", encoded)
+	}
+	for _, s := range []string{"10,2", "0x10", "0b11", "0o7", "010", "1_000", "+1", ".5", "1.", "1/3", "Inf", "1e99999"} {
+		if a, err := mercadopago.ParseAmount(s); err == nil {
+			t.Errorf("Expected an error parsing invalid amount %s and got %s", s, a)
+		}
+	}
+	var a mercadopago.Amount
+	if err := json.Unmarshal([]byte(`"0x10"`), &a); err == nil {
+		t.Errorf("Expected an error decoding invalid amount \"0x10\" and got %s", a)
+	}
+}
+
+// TestAmountArithmetic - Adding amounts should not accumulate rounding errors
+func TestAmountArithmetic(t *testing.T) {
+	fmt.Println("mp_test : AmountArithmetic")
+	var total mercadopago.Amount
+	for i := 0; i < 10; i++ {
+		total = total.Add(mercadopago.MustParseAmount("0.1"))
+	}
+	if total != mercadopago.NewAmount(1, 0) {
+		t.Errorf("Expected ten times 0.1 to be 1 and got %s", total)
+	}
+	price := mercadopago.NewAmount(1020, 2)
+	if got := price.Mul(3).Sub(mercadopago.MustParseAmount("0.6")); got.String() != "30" {
+		t.Errorf("Expected 3 * 10.20 - 0.60 to be 30 and got %s", got)
+	}
+	if price.Cmp(total) != 1 || total.Cmp(price) != -1 || price.Cmp(price) != 0 {
+		t.Errorf("Unexpected comparison of %s and %s", price, total)
+	}
+}
+
+// TestAmountOverflow - Operations out of range should panic instead of wrapping around
+func TestAmountOverflow(t *testing.T) {
+	fmt.Println("mp_test : AmountOverflow")
+	max := mercadopago.Amount(math.MaxInt64)
+	cases := map[string]func(){
+		"Mul":       func() { mercadopago.NewAmount(1, 0).Mul(1e13) },
+		"Add":       func() { max.Add(1) },
+		"Sub":       func() { (-max).Sub(2) },
+		"NewAmount": func() { mercadopago.NewAmount(1e13, 0) },
+	}
+	for name, op := range cases {
+		func() {
+			defer func() {
+				if r := recover(); r != mercadopago.ErrAmountOverflow {
+					t.Errorf("Expected %s to panic with ErrAmountOverflow and got %v", name, r)
+				}
+			}()
+			op()
+		}()
+	}
+	if got := mercadopago.NewAmount(1, 0).Mul(1e6); got.String() != "1000000" {
+		t.Errorf("Expected 1000000 and got %s", got)
+	}
+}
+
+// TestAmountCurrency - Amounts should be rounded and formatted to the decimals of their currency
+func TestAmountCurrency(t *testing.T) {
+	fmt.Println("mp_test : AmountCurrency")
+	installment := mercadopago.MustParseAmount("3.835")
+	if s := installment.Format("ARS"); s != "3.84" {
+		t.Errorf("Expected 3.84 ARS and got %s", s)
+	}
+	if n := installment.MinorUnits("ARS"); n != 384 {
+		t.Errorf("Expected 384 cents and got %d", n)
+	}
+	if s := mercadopago.NewAmount(10, 0).Format("BRL"); s != "10.00" {
+		t.Errorf("Expected 10.00 BRL and got %s", s)
+	}
+	if s := mercadopago.MustParseAmount("1499.5").Format("CLP"); s != "1500" {
+		t.Errorf("Expected 1500 CLP and got %s", s)
+	}
+	if a := mercadopago.AmountFromMinorUnits(1020, "MXN"); a.String() != "10.2" {
+		t.Errorf("Expected 10.2 MXN and got %s", a)
+	}
+	if a := mercadopago.MustParseAmount("-2.5").Round("CLP"); a.String() != "-3" {
+		t.Errorf("Expected -2.5 CLP to be rounded to -3 and got %s", a)
+	}
+}
@@ -0,0 +1,58 @@
+package mercadopago
+
+import (
+	"context"
+	"net/http"
+)
+
+// authMode defines which credentials authenticate an API call
+type authMode int
+
+const (
+	noAuth     authMode = iota // Public resources, no access token required
+	basicAuth                  // Access Token obtained with the client credentials (Basic workflow)
+	customAuth                 // Access Token provided on NewMP (Custom workflow)
+)
+
+// AuthStrategy defines how the access token is sent to the MP API
+type AuthStrategy int
+
+const (
+	// BearerAuth sends the token in the "Authorization: Bearer" header (default)
+	BearerAuth AuthStrategy = iota
+	// QueryParamAuth sends the token as an access_token query string parameter.
+	// This is the legacy behavior, it exposes the token in proxy and server logs.
+	QueryParamAuth
+)
+
+// WithAuthStrategy sets how the access token is sent to the MP API
+func WithAuthStrategy(strategy AuthStrategy) Option {
+	return func(mp *MP) {
+		mp.authStrategy = strategy
+	}
+}
+
+// accessToken returns the token used to authenticate a call with the given credentials
+func (mp *MP) accessToken(ctx context.Context, auth authMode) (string, error) {
+	switch auth {
+	case basicAuth:
+		return mp.basicAccessToken(ctx)
+	case customAuth:
+		return mp.CustomAccessToken, nil
+	}
+	return "", nil
+}
+
+// authorize adds the access token to the request according to the configured strategy
+func (mp *MP) authorize(r *http.Request, accessToken string) {
+	if accessToken == "" {
+		return
+	}
+	if mp.authStrategy == QueryParamAuth {
+		q := r.URL.Query()
+		q.Set("access_token", accessToken)
+		r.URL.RawQuery = q.Encode()
+		return
+	}
+	r.Header.Set("Authorization", "Bearer "+accessToken)
+}
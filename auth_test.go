@@ -0,0 +1,86 @@
+package mercadopago_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/gpascual2/mp-sdk-go"
+)
+
+// TestBearerAuth - The access token should be sent in the Authorization header by default
+func TestBearerAuth(t *testing.T) {
+	fmt.Println("mp_test : BearerAuth")
+	var query url.Values
+	var authorization string
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		authorization = r.Header.Get("Authorization")
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	local := newFakeMP(server.URL)
+	if _, err := local.GetPayment("1"); err != nil {
+		t.Fatalf("Error getting the payment: %v", err)
+	}
+	if authorization != "Bearer token" {
+		t.Errorf("Expected Authorization header to be Bearer token and got %s", authorization)
+	}
+	if query.Get("access_token") != "" {
+		t.Errorf("Expected no access_token in the query string and got %v", query)
+	}
+}
+
+// TestQueryParamAuth - The legacy strategy should send the access token in the query string
+func TestQueryParamAuth(t *testing.T) {
+	fmt.Println("mp_test : QueryParamAuth")
+	var query url.Values
+	var authorization string
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		authorization = r.Header.Get("Authorization")
+		fmt.Fprint(w, `{"results":[]}`)
+	})
+
+	local := newFakeMP(server.URL, mercadopago.WithAuthStrategy(mercadopago.QueryParamAuth))
+	if _, err := local.GetPaymentsByRef("ExRef"); err != nil {
+		t.Fatalf("Error getting the payments: %v", err)
+	}
+	if query.Get("access_token") != "token" || query.Get("external_reference") != "ExRef" {
+		t.Errorf("Expected access_token and external_reference in the query string and got %v", query)
+	}
+	if authorization != "" {
+		t.Errorf("Expected no Authorization header and got %s", authorization)
+	}
+}
+
+// TestSearchFiltersQuery - The filters of GET calls should be sent in the query string, not in the body
+func TestSearchFiltersQuery(t *testing.T) {
+	fmt.Println("mp_test : SearchFiltersQuery")
+	var query url.Values
+	var body []byte
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		body, _ = ioutil.ReadAll(r.Body)
+		if r.Method != "GET" || r.URL.Path != "/v1/payments/search" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		fmt.Fprint(w, `{"results":[]}`)
+	})
+
+	local := newFakeMP(server.URL)
+	filters := &url.Values{}
+	filters.Add("status", "approved")
+	filters.Add("external_reference", "ExRef")
+	if _, err := local.PaymentsSearch(filters); err != nil {
+		t.Fatalf("Error searching the payments: %v", err)
+	}
+	if query.Get("status") != "approved" || query.Get("external_reference") != "ExRef" {
+		t.Errorf("Expected the search filters in the query string and got %v", query)
+	}
+	if len(body) != 0 {
+		t.Errorf("Expected an empty body and got %s", body)
+	}
+}
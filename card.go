@@ -0,0 +1,202 @@
+package mercadopago
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+// SaveCard Saves a card for a customer
+//	@param customerID
+//	@param token: card token obtained for the card to save
+//	@param opts
+//	@return json
+func (mp *MP) SaveCard(customerID string, token string, opts ...RequestOption) (*Card, error) {
+	return mp.SaveCardWithContext(context.Background(), customerID, token, opts...)
+}
+
+// SaveCardWithContext Saves a card for a customer
+//	@param ctx
+//	@param customerID
+//	@param token: card token obtained for the card to save
+//	@param opts
+//	@return json
+func (mp *MP) SaveCardWithContext(ctx context.Context, customerID string, token string, opts ...RequestOption) (*Card, error) {
+	res := &Card{}
+	uri := fmt.Sprintf("/v1/customers/%v/cards", customerID)
+	// Call POST method
+	r, err := mp.post(ctx, uri, &cardRequest{Token: token}, customAuth, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	// Check response status
+	if r.StatusCode != 200 && r.StatusCode != 201 {
+		return nil, newResponseError(r)
+	}
+	// Read response Body and unmarshall content
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListCards Get the saved cards of a customer
+//	@param customerID
+//	@return json
+func (mp *MP) ListCards(customerID string) ([]Card, error) {
+	return mp.ListCardsWithContext(context.Background(), customerID)
+}
+
+// ListCardsWithContext Get the saved cards of a customer
+//	@param ctx
+//	@param customerID
+//	@return json
+func (mp *MP) ListCardsWithContext(ctx context.Context, customerID string) ([]Card, error) {
+	res := []Card{}
+	uri := fmt.Sprintf("/v1/customers/%v/cards", customerID)
+	// Call GET method
+	r, err := mp.jget(ctx, uri, nil, customAuth)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	// Check response status
+	if r.StatusCode != 200 {
+		return nil, newResponseError(r)
+	}
+	// Read response Body and unmarshall content
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetCard Get a saved card of a customer
+//	@param customerID
+//	@param cardID
+//	@return json
+func (mp *MP) GetCard(customerID string, cardID string) (*Card, error) {
+	return mp.GetCardWithContext(context.Background(), customerID, cardID)
+}
+
+// GetCardWithContext Get a saved card of a customer
+//	@param ctx
+//	@param customerID
+//	@param cardID
+//	@return json
+func (mp *MP) GetCardWithContext(ctx context.Context, customerID string, cardID string) (*Card, error) {
+	res := &Card{}
+	uri := fmt.Sprintf("/v1/customers/%v/cards/%v", customerID, cardID)
+	// Call GET method
+	r, err := mp.jget(ctx, uri, nil, customAuth)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	// Check response status
+	if r.StatusCode != 200 {
+		return nil, newResponseError(r)
+	}
+	// Read response Body and unmarshall content
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DeleteCard Deletes a saved card of a customer
+//	@param customerID
+//	@param cardID
+//	@return json
+func (mp *MP) DeleteCard(customerID string, cardID string) (*Card, error) {
+	return mp.DeleteCardWithContext(context.Background(), customerID, cardID)
+}
+
+// DeleteCardWithContext Deletes a saved card of a customer
+//	@param ctx
+//	@param customerID
+//	@param cardID
+//	@return json
+func (mp *MP) DeleteCardWithContext(ctx context.Context, customerID string, cardID string) (*Card, error) {
+	res := &Card{}
+	uri := fmt.Sprintf("/v1/customers/%v/cards/%v", customerID, cardID)
+	// Call DELETE method
+	r, err := mp.del(ctx, uri, customAuth)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	// Check response status
+	if r.StatusCode != 200 {
+		return nil, newResponseError(r)
+	}
+	// Read response Body and unmarshall content
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateCardToken Creates a card token, using the public key when set or the access token otherwise
+//	@param card
+//	@param opts
+//	@return json
+func (mp *MP) CreateCardToken(card *CardTokenRequest, opts ...RequestOption) (*CardToken, error) {
+	return mp.CreateCardTokenWithContext(context.Background(), card, opts...)
+}
+
+// CreateCardTokenWithContext Creates a card token, using the public key when set or the access token otherwise
+//	@param ctx
+//	@param card
+//	@param opts
+//	@return json
+func (mp *MP) CreateCardTokenWithContext(ctx context.Context, card *CardTokenRequest, opts ...RequestOption) (*CardToken, error) {
+	res := &CardToken{}
+	uri := fmt.Sprintf("/v1/card_tokens")
+	// Call POST method
+	var r *http.Response
+	var err error
+	if mp.PublicKey != "" {
+		opts = append([]RequestOption{withQuery(url.Values{"public_key": {mp.PublicKey}})}, opts...)
+		r, err = mp.post(ctx, uri, card, noAuth, opts...)
+	} else {
+		r, err = mp.post(ctx, uri, card, customAuth, opts...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	// Check response status
+	if r.StatusCode != 200 && r.StatusCode != 201 {
+		return nil, newResponseError(r)
+	}
+	// Read response Body and unmarshall content
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
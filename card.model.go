@@ -0,0 +1,76 @@
+package mercadopago
+
+// Card is the data struct for the saved cards of a customer
+type Card struct {
+	ID              string `json:"id,omitempty"`
+	CustomerID      string `json:"customer_id,omitempty"`
+	UserID          FlexID `json:"user_id,omitempty"`
+	ExpirationMonth int    `json:"expiration_month,omitempty"`
+	ExpirationYear  int    `json:"expiration_year,omitempty"`
+	FirstSixDigits  string `json:"first_six_digits,omitempty"`
+	LastFourDigits  string `json:"last_four_digits,omitempty"`
+	DateCreated     string `json:"date_created,omitempty"`
+	DateLastUpdated string `json:"date_last_updated,omitempty"`
+	LiveMode        bool   `json:"live_mode,omitempty"`
+	PaymentMethod   struct {
+		ID              string `json:"id,omitempty"`
+		Name            string `json:"name,omitempty"`
+		PaymentTypeID   string `json:"payment_type_id,omitempty"`
+		Thumbnail       string `json:"thumbnail,omitempty"`
+		SecureThumbnail string `json:"secure_thumbnail,omitempty"`
+	} `json:"payment_method,omitempty"`
+	SecurityCode struct {
+		Length       int    `json:"length,omitempty"`
+		CardLocation string `json:"card_location,omitempty"`
+	} `json:"security_code,omitempty"`
+	Issuer struct {
+		ID   FlexID `json:"id,omitempty"`
+		Name string `json:"name,omitempty"`
+	} `json:"issuer,omitempty"`
+	Cardholder struct {
+		Name           string         `json:"name,omitempty"`
+		Identification Identification `json:"identification,omitempty"`
+	} `json:"cardholder,omitempty"`
+}
+
+// cardRequest is the body sent to save a card
+type cardRequest struct {
+	Token string `json:"token"`
+}
+
+// CardTokenRequest is the data to create a card token, either from the card data
+// (e.g. test cards in sandbox) or from a saved card ID and its security code
+type CardTokenRequest struct {
+	CardNumber      string `json:"card_number,omitempty"`
+	SecurityCode    string `json:"security_code,omitempty"`
+	ExpirationMonth int    `json:"expiration_month,omitempty"`
+	ExpirationYear  int    `json:"expiration_year,omitempty"`
+	CardID          string `json:"card_id,omitempty"`
+	Cardholder      struct {
+		Name           string         `json:"name,omitempty"`
+		Identification Identification `json:"identification,omitempty"`
+	} `json:"cardholder,omitempty"`
+}
+
+// CardToken is the data struct for card tokens MP API, its ID is used as Payment.Token
+type CardToken struct {
+	ID                 string `json:"id,omitempty"`
+	PublicKey          string `json:"public_key,omitempty"`
+	CardID             FlexID `json:"card_id,omitempty"`
+	Status             string `json:"status,omitempty"`
+	FirstSixDigits     string `json:"first_six_digits,omitempty"`
+	LastFourDigits     string `json:"last_four_digits,omitempty"`
+	CardNumberLength   int    `json:"card_number_length,omitempty"`
+	SecurityCodeLength int    `json:"security_code_length,omitempty"`
+	ExpirationMonth    int    `json:"expiration_month,omitempty"`
+	ExpirationYear     int    `json:"expiration_year,omitempty"`
+	LuhnValidation     bool   `json:"luhn_validation,omitempty"`
+	LiveMode           bool   `json:"live_mode,omitempty"`
+	DateCreated        string `json:"date_created,omitempty"`
+	DateLastUpdated    string `json:"date_last_updated,omitempty"`
+	DateDue            string `json:"date_due,omitempty"`
+	Cardholder         struct {
+		Name           string         `json:"name,omitempty"`
+		Identification Identification `json:"identification,omitempty"`
+	} `json:"cardholder,omitempty"`
+}
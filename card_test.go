@@ -0,0 +1,111 @@
+package mercadopago_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gpascual2/mp-sdk-go"
+)
+
+// TestSaveCard - A card token should be saved as a card of the customer
+func TestSaveCard(t *testing.T) {
+	fmt.Println("mp_test : SaveCard")
+	var sent map[string]string
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/v1/customers/123-abc/cards" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		json.NewDecoder(r.Body).Decode(&sent)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"1490022319978","customer_id":"123-abc","user_id":130379930,"last_four_digits":"3704","payment_method":{"id":"visa"},"issuer":{"id":25,"name":"Visa"}}`)
+	})
+
+	local := newFakeMP(server.URL)
+	card, err := local.SaveCard("123-abc", "card-token")
+	if err != nil {
+		t.Fatalf("Error saving the card: %v", err)
+	}
+	if sent["token"] != "card-token" {
+		t.Errorf("Expected the card token to be sent and got %v", sent)
+	}
+	if card.ID != "1490022319978" || card.Issuer.ID != "25" || card.PaymentMethod.ID != "visa" {
+		t.Errorf("Unexpected card saved: %+v", card)
+	}
+}
+
+// TestPaymentWithSavedCard - A payment charged to a saved card should reference the customer
+func TestPaymentWithSavedCard(t *testing.T) {
+	fmt.Println("mp_test : PaymentWithSavedCard")
+	var sent struct {
+		Token string `json:"token"`
+		Payer struct {
+			Type string `json:"type"`
+			ID   string `json:"id"`
+		} `json:"payer"`
+	}
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&sent)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":8262805,"status":"approved","payer":{"id":130379930}}`)
+	})
+
+	local := newFakeMP(server.URL)
+	payment := &mercadopago.Payment{TransactionAmount: mercadopago.MustParseAmount("10.2"), Installments: 1}
+	payment.UseSavedCard("123-abc", "cvv-token")
+	pmt, err := local.CreatePayment(payment)
+	if err != nil {
+		t.Fatalf("Error creating the payment: %v", err)
+	}
+	if sent.Token != "cvv-token" || sent.Payer.Type != "customer" || sent.Payer.ID != "123-abc" {
+		t.Errorf("Expected the saved card to be referenced and got %+v", sent)
+	}
+	if pmt.Payer.ID != "130379930" {
+		t.Errorf("Expected numeric payer ID to be decoded and got %s", pmt.Payer.ID)
+	}
+}
+
+// TestCreateCardToken - A card token should be created with the public key
+func TestCreateCardToken(t *testing.T) {
+	fmt.Println("mp_test : CreateCardToken")
+	var sent mercadopago.CardTokenRequest
+	var publicKey, authorization, idempotencyKey string
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/v1/card_tokens" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		publicKey = r.URL.Query().Get("public_key")
+		authorization = r.Header.Get("Authorization")
+		idempotencyKey = r.Header.Get("X-Idempotency-Key")
+		json.NewDecoder(r.Body).Decode(&sent)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"ff8080814c11e237014c1ff593b57b4d","status":"active","first_six_digits":"450995","last_four_digits":"3704","luhn_validation":true}`)
+	})
+
+	local := newFakeMP(server.URL, mercadopago.WithPublicKey("TEST-public-key"))
+	card := &mercadopago.CardTokenRequest{
+		CardNumber:      "4509953566233704",
+		SecurityCode:    "123",
+		ExpirationMonth: 11,
+		ExpirationYear:  2030,
+	}
+	card.Cardholder.Name = "APRO"
+	card.Cardholder.Identification = mercadopago.Identification{Type: "DNI", Number: "12345678"}
+	token, err := local.CreateCardToken(card, mercadopago.WithIdempotencyKey("card-key"))
+	if err != nil {
+		t.Fatalf("Error creating the card token: %v", err)
+	}
+	if publicKey != "TEST-public-key" || authorization != "" {
+		t.Errorf("Expected only the public key to be sent and got %s / %s", publicKey, authorization)
+	}
+	if idempotencyKey != "card-key" {
+		t.Errorf("Expected idempotency key card-key and got %s", idempotencyKey)
+	}
+	if sent.CardNumber != card.CardNumber || sent.Cardholder.Name != "APRO" {
+		t.Errorf("Expected the card data to be sent and got %+v", sent)
+	}
+	if token.ID == "" || token.LastFourDigits != "3704" {
+		t.Errorf("Unexpected card token created: %+v", token)
+	}
+}
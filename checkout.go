@@ -1,6 +1,7 @@
 package mercadopago
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -8,18 +9,29 @@ import (
 
 // CreatePreference Creates a checkout preference
 //	@param preference
+//	@param opts
 //	@return json
-func (mp *MP) CreatePreference(preference *Preference) (*Preference, error) {
+func (mp *MP) CreatePreference(preference *Preference, opts ...RequestOption) (*Preference, error) {
+	return mp.CreatePreferenceWithContext(context.Background(), preference, opts...)
+}
+
+// CreatePreferenceWithContext Creates a checkout preference
+//	@param ctx
+//	@param preference
+//	@param opts
+//	@return json
+func (mp *MP) CreatePreferenceWithContext(ctx context.Context, preference *Preference, opts ...RequestOption) (*Preference, error) {
 	res := &Preference{}
 	uri := fmt.Sprintf("/checkout/preferences")
 	// Call POST method
-	r, err := mp.post(uri, preference, 1)
+	r, err := mp.post(ctx, uri, preference, basicAuth, opts...)
 	if err != nil {
 		return nil, err
 	}
+	defer r.Body.Close()
 	// Check response status
 	if r.StatusCode != 200 && r.StatusCode != 201 {
-		return nil, fmt.Errorf("Bad status received in HTTP response: %v", r.Status)
+		return nil, newResponseError(r)
 	}
 	// Read response Body and unmarshall content
 	body, err := ioutil.ReadAll(r.Body)
@@ -36,16 +48,25 @@ func (mp *MP) CreatePreference(preference *Preference) (*Preference, error) {
 //	@param id
 //	@return json
 func (mp *MP) GetPreference(id string) (*Preference, error) {
+	return mp.GetPreferenceWithContext(context.Background(), id)
+}
+
+// GetPreferenceWithContext Get a checkout preference
+//	@param ctx
+//	@param id
+//	@return json
+func (mp *MP) GetPreferenceWithContext(ctx context.Context, id string) (*Preference, error) {
 	res := &Preference{}
 	uri := fmt.Sprintf("/checkout/preferences/%v", id)
 	// Call GET method
-	r, err := mp.get(uri, nil, 1)
+	r, err := mp.get(ctx, uri, nil, basicAuth)
 	if err != nil {
 		return nil, err
 	}
+	defer r.Body.Close()
 	// Check response status
 	if r.StatusCode != 200 {
-		return nil, fmt.Errorf("Bad status received in HTTP response: %v", r.Status)
+		return nil, newResponseError(r)
 	}
 	// Read response Body and unmarshall content
 	body, err := ioutil.ReadAll(r.Body)
@@ -57,3 +78,155 @@ func (mp *MP) GetPreference(id string) (*Preference, error) {
 	}
 	return res, nil
 }
+
+// UpdatePreference Updates a checkout preference with the given changes
+//	@param id
+//	@param patch: a *Preference, whose empty fields are left unchanged, or any JSON encodable value
+//	with the fields to change, e.g. map[string]interface{}{"expires": false} to set zero values
+//	@param opts
+//	@return json
+func (mp *MP) UpdatePreference(id string, patch interface{}, opts ...RequestOption) (*Preference, error) {
+	return mp.UpdatePreferenceWithContext(context.Background(), id, patch, opts...)
+}
+
+// UpdatePreferenceWithContext Updates a checkout preference with the given changes
+//	@param ctx
+//	@param id
+//	@param patch: a *Preference, whose empty fields are left unchanged, or any JSON encodable value
+//	with the fields to change, e.g. map[string]interface{}{"expires": false} to set zero values
+//	@param opts
+//	@return json
+func (mp *MP) UpdatePreferenceWithContext(ctx context.Context, id string, patch interface{}, opts ...RequestOption) (*Preference, error) {
+	res := &Preference{}
+	uri := fmt.Sprintf("/checkout/preferences/%v", id)
+	data := patch
+	if preference, ok := patch.(*Preference); ok {
+		fields, err := partialUpdate(preference)
+		if err != nil {
+			return nil, err
+		}
+		data = fields
+	}
+	// Call PUT method
+	r, err := mp.put(ctx, uri, data, basicAuth, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	// Check response status
+	if r.StatusCode != 200 && r.StatusCode != 201 {
+		return nil, newResponseError(r)
+	}
+	// Read response Body and unmarshall content
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SearchPreferences Search for checkout preferences using a filter set
+//	@param filters
+//	@return json
+func (mp *MP) SearchPreferences(filters *PreferenceSearchFilters) (*PreferenceSearch, error) {
+	return mp.SearchPreferencesWithContext(context.Background(), filters)
+}
+
+// SearchPreferencesWithContext Search for checkout preferences using a filter set
+//	@param ctx
+//	@param filters
+//	@return json
+func (mp *MP) SearchPreferencesWithContext(ctx context.Context, filters *PreferenceSearchFilters) (*PreferenceSearch, error) {
+	res := &PreferenceSearch{}
+	uri := fmt.Sprintf("/checkout/preferences/search")
+	// Call GET method
+	r, err := mp.get(ctx, uri, filters.values(), basicAuth)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	// Check response status
+	if r.StatusCode != 200 {
+		return nil, newResponseError(r)
+	}
+	// Read response Body and unmarshall content
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// PreferenceIterator walks all the checkout preferences matching a search, fetching pages as needed
+//
+//	it := mp.IterPreferences(ctx, filters)
+//	for it.Next() {
+//		pref := it.Preference()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type PreferenceIterator struct {
+	mp      *MP
+	ctx     context.Context
+	filters PreferenceSearchFilters
+	page    []PreferenceSummary
+	current *PreferenceSummary
+	done    bool
+	err     error
+}
+
+// IterPreferences returns an iterator over all the checkout preferences matching filters,
+// starting at filters.Offset and fetching filters.Limit preferences per page
+func (mp *MP) IterPreferences(ctx context.Context, filters *PreferenceSearchFilters) *PreferenceIterator {
+	it := &PreferenceIterator{mp: mp, ctx: ctx}
+	if filters != nil {
+		it.filters = *filters
+	}
+	return it
+}
+
+// Next advances to the next preference, returning false when there are no more or on error
+func (it *PreferenceIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	for len(it.page) == 0 {
+		if it.done {
+			it.current = nil
+			return false
+		}
+		if err := it.ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+		res, err := it.mp.SearchPreferencesWithContext(it.ctx, &it.filters)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page = res.Elements
+		it.filters.Offset += len(res.Elements)
+		if len(res.Elements) == 0 || it.filters.Offset >= res.Total {
+			it.done = true
+		}
+	}
+	it.current = &it.page[0]
+	it.page = it.page[1:]
+	return true
+}
+
+// Preference returns the current preference
+func (it *PreferenceIterator) Preference() *PreferenceSummary {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *PreferenceIterator) Err() error {
+	return it.err
+}
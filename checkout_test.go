@@ -1,8 +1,12 @@
 package mercadopago_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/gpascual2/mp-sdk-go"
 )
@@ -23,7 +27,7 @@ func init() {
 		Title:      "Item1_title",
 		Quantity:   1,
 		CurrencyID: "ARS",
-		UnitPrice:  10.2,
+		UnitPrice:  mercadopago.MustParseAmount("10.2"),
 	})
 	prefBase.PaymentMethods.Installments = 1
 	prefBase.PaymentMethods.ExcludedPaymentTypes = append(prefBase.PaymentMethods.ExcludedPaymentTypes, mercadopago.ID{ID: "ticket"})
@@ -58,3 +62,96 @@ func TestGetPreference(t *testing.T) {
 		t.Errorf("Expected Preference Item to equal the requested one. Sent: %v / Got: %v", prefBase.Items[0], prefGet.Items[0])
 	}
 }
+
+// TestUpdatePreference - Only the fields set should be sent when updating a checkout preference
+func TestUpdatePreference(t *testing.T) {
+	fmt.Println("mp_test : UpdatePreference")
+	var sent map[string]interface{}
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/checkout/preferences/pref-1" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		json.NewDecoder(r.Body).Decode(&sent)
+		fmt.Fprint(w, `{"id":"pref-1","external_reference":"ExRef","back_urls":{"success":"https://winterfell.north/ok"}}`)
+	})
+
+	local := newFakeMP(server.URL)
+	update := &mercadopago.Preference{}
+	update.BackUrls.Success = "https://winterfell.north/ok"
+	pref, err := local.UpdatePreference("pref-1", update)
+	if err != nil {
+		t.Fatalf("Error updating the checkout preference: %v", err)
+	}
+	if len(sent) != 1 || sent["back_urls"] == nil {
+		t.Errorf("Expected only back_urls to be sent and got %v", sent)
+	}
+	if pref.ExternalReference != "ExRef" {
+		t.Errorf("Expected untouched external reference ExRef and got %s", pref.ExternalReference)
+	}
+}
+
+// TestUpdatePreferenceExpiration - Zero values such as expires false should be sent with an explicit patch
+func TestUpdatePreferenceExpiration(t *testing.T) {
+	fmt.Println("mp_test : UpdatePreferenceExpiration")
+	var sent map[string]interface{}
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&sent)
+		fmt.Fprint(w, `{"id":"pref-1","expires":false}`)
+	})
+
+	local := newFakeMP(server.URL)
+	patch := map[string]interface{}{"expires": false, "expiration_date_to": nil}
+	pref, err := local.UpdatePreference("pref-1", patch)
+	if err != nil {
+		t.Fatalf("Error updating the checkout preference: %v", err)
+	}
+	if expires, ok := sent["expires"]; !ok || expires != false {
+		t.Errorf("Expected expires false to be sent and got %v", sent)
+	}
+	if v, ok := sent["expiration_date_to"]; !ok || v != nil {
+		t.Errorf("Expected expiration_date_to to be cleared and got %v", sent)
+	}
+	if pref.Expires {
+		t.Errorf("Expected the preference not to expire")
+	}
+}
+
+// TestIterPreferences - All the checkout preferences matching a search should be walked across pages
+func TestIterPreferences(t *testing.T) {
+	fmt.Println("mp_test : IterPreferences")
+	var offsets []string
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("external_reference") != "ExRef" || q.Get("range") != "date_created" {
+			t.Errorf("Unexpected search filters %v", q)
+		}
+		offsets = append(offsets, q.Get("offset"))
+		switch q.Get("offset") {
+		case "":
+			fmt.Fprint(w, `{"elements":[{"id":"pref-1"},{"id":"pref-2"}],"next_offset":2,"total":3}`)
+		default:
+			fmt.Fprint(w, `{"elements":[{"id":"pref-3"}],"next_offset":3,"total":3}`)
+		}
+	})
+
+	local := newFakeMP(server.URL)
+	filters := &mercadopago.PreferenceSearchFilters{
+		ExternalReference: "ExRef",
+		DateCreatedFrom:   time.Now().AddDate(0, 0, -1),
+		Limit:             2,
+	}
+	var ids []string
+	it := local.IterPreferences(context.Background(), filters)
+	for it.Next() {
+		ids = append(ids, it.Preference().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Error iterating the checkout preferences: %v", err)
+	}
+	if len(ids) != 3 || ids[2] != "pref-3" {
+		t.Errorf("Expected 3 preferences and got %v", ids)
+	}
+	if len(offsets) != 2 || offsets[1] != "2" {
+		t.Errorf("Expected 2 pages fetched and got offsets %v", offsets)
+	}
+}
@@ -0,0 +1,196 @@
+package mercadopago
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+)
+
+// CreateCustomer Creates a customer
+//	@param customer
+//	@param opts
+//	@return json
+func (mp *MP) CreateCustomer(customer *Customer, opts ...RequestOption) (*Customer, error) {
+	return mp.CreateCustomerWithContext(context.Background(), customer, opts...)
+}
+
+// CreateCustomerWithContext Creates a customer
+//	@param ctx
+//	@param customer
+//	@param opts
+//	@return json
+func (mp *MP) CreateCustomerWithContext(ctx context.Context, customer *Customer, opts ...RequestOption) (*Customer, error) {
+	res := &Customer{}
+	uri := fmt.Sprintf("/v1/customers")
+	// Call POST method
+	r, err := mp.post(ctx, uri, customer, customAuth, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	// Check response status
+	if r.StatusCode != 200 && r.StatusCode != 201 {
+		return nil, newResponseError(r)
+	}
+	// Read response Body and unmarshall content
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetCustomer Get a customer by ID
+//	@param id
+//	@return json
+func (mp *MP) GetCustomer(id string) (*Customer, error) {
+	return mp.GetCustomerWithContext(context.Background(), id)
+}
+
+// GetCustomerWithContext Get a customer by ID
+//	@param ctx
+//	@param id
+//	@return json
+func (mp *MP) GetCustomerWithContext(ctx context.Context, id string) (*Customer, error) {
+	res := &Customer{}
+	uri := fmt.Sprintf("/v1/customers/%v", id)
+	// Call GET method
+	r, err := mp.jget(ctx, uri, nil, customAuth)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	// Check response status
+	if r.StatusCode != 200 {
+		return nil, newResponseError(r)
+	}
+	// Read response Body and unmarshall content
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// UpdateCustomer Updates a customer. Only the fields set on customer are changed.
+//	@param id
+//	@param customer
+//	@param opts
+//	@return json
+func (mp *MP) UpdateCustomer(id string, customer *Customer, opts ...RequestOption) (*Customer, error) {
+	return mp.UpdateCustomerWithContext(context.Background(), id, customer, opts...)
+}
+
+// UpdateCustomerWithContext Updates a customer. Only the fields set on customer are changed.
+//	@param ctx
+//	@param id
+//	@param customer
+//	@param opts
+//	@return json
+func (mp *MP) UpdateCustomerWithContext(ctx context.Context, id string, customer *Customer, opts ...RequestOption) (*Customer, error) {
+	res := &Customer{}
+	uri := fmt.Sprintf("/v1/customers/%v", id)
+	data, err := partialUpdate(customer)
+	if err != nil {
+		return nil, err
+	}
+	// Call PUT method
+	r, err := mp.put(ctx, uri, data, customAuth, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	// Check response status
+	if r.StatusCode != 200 {
+		return nil, newResponseError(r)
+	}
+	// Read response Body and unmarshall content
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DeleteCustomer Deletes a customer
+//	@param id
+//	@return json
+func (mp *MP) DeleteCustomer(id string) (*Customer, error) {
+	return mp.DeleteCustomerWithContext(context.Background(), id)
+}
+
+// DeleteCustomerWithContext Deletes a customer
+//	@param ctx
+//	@param id
+//	@return json
+func (mp *MP) DeleteCustomerWithContext(ctx context.Context, id string) (*Customer, error) {
+	res := &Customer{}
+	uri := fmt.Sprintf("/v1/customers/%v", id)
+	// Call DELETE method
+	r, err := mp.del(ctx, uri, customAuth)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	// Check response status
+	if r.StatusCode != 200 {
+		return nil, newResponseError(r)
+	}
+	// Read response Body and unmarshall content
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SearchCustomers Search for customers by email
+//	@param email
+//	@return json
+func (mp *MP) SearchCustomers(email string) (*CustomerSearch, error) {
+	return mp.SearchCustomersWithContext(context.Background(), email)
+}
+
+// SearchCustomersWithContext Search for customers by email
+//	@param ctx
+//	@param email
+//	@return json
+func (mp *MP) SearchCustomersWithContext(ctx context.Context, email string) (*CustomerSearch, error) {
+	res := &CustomerSearch{}
+	uri := fmt.Sprintf("/v1/customers/search")
+	data := &url.Values{}
+	data.Add("email", email)
+	// Call GET method
+	r, err := mp.get(ctx, uri, data, customAuth)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	// Check response status
+	if r.StatusCode != 200 {
+		return nil, newResponseError(r)
+	}
+	// Read response Body and unmarshall content
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
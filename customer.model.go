@@ -0,0 +1,31 @@
+package mercadopago
+
+// Customer is the data struct for customers MP API
+type Customer struct {
+	ID              string                 `json:"id,omitempty"`
+	Email           string                 `json:"email,omitempty"`
+	FirstName       string                 `json:"first_name,omitempty"`
+	LastName        string                 `json:"last_name,omitempty"`
+	Phone           Phone                  `json:"phone,omitempty"`
+	Identification  Identification         `json:"identification,omitempty"`
+	Address         Address                `json:"address,omitempty"`
+	DefaultAddress  string                 `json:"default_address,omitempty"`
+	DefaultCard     string                 `json:"default_card,omitempty"`
+	Description     string                 `json:"description,omitempty"`
+	DateRegistered  string                 `json:"date_registered,omitempty"`
+	DateCreated     string                 `json:"date_created,omitempty"`
+	DateLastUpdated string                 `json:"date_last_updated,omitempty"`
+	LiveMode        bool                   `json:"live_mode,omitempty"`
+	Cards           []Card                 `json:"cards,omitempty"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// CustomerSearch is the data struct for customer search results
+type CustomerSearch struct {
+	Paging struct {
+		Total  int `json:"total,omitempty"`
+		Limit  int `json:"limit,omitempty"`
+		Offset int `json:"offset,omitempty"`
+	} `json:"paging,omitempty"`
+	Results []Customer `json:"results,omitempty"`
+}
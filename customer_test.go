@@ -0,0 +1,81 @@
+package mercadopago_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gpascual2/mp-sdk-go"
+)
+
+// newCustomerAPI returns a fake MP customers API keeping a single customer
+func newCustomerAPI(t *testing.T) *httptest.Server {
+	customer := map[string]interface{}{}
+	return newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/v1/customers":
+			json.NewDecoder(r.Body).Decode(&customer)
+			customer["id"] = "123-abc"
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == "PUT" && r.URL.Path == "/v1/customers/123-abc":
+			var update map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&update)
+			for k, v := range update {
+				customer[k] = v
+			}
+		case r.Method == "GET" && r.URL.Path == "/v1/customers/search":
+			if r.URL.Query().Get("email") != customer["email"] {
+				fmt.Fprint(w, `{"paging":{"total":0},"results":[]}`)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"paging": map[string]int{"total": 1}, "results": []interface{}{customer}})
+			return
+		case r.Method == "DELETE" && r.URL.Path == "/v1/customers/123-abc":
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(customer)
+	})
+}
+
+// TestCustomerLifecycle - A customer should be created, updated, found by email and deleted
+func TestCustomerLifecycle(t *testing.T) {
+	fmt.Println("mp_test : CustomerLifecycle")
+	server := newCustomerAPI(t)
+	local := newFakeMP(server.URL)
+
+	customer := &mercadopago.Customer{Email: "jonsnow@winterfell.north", FirstName: "Jon", LastName: "Snow"}
+	customer.Identification = mercadopago.Identification{Type: "DNI", Number: "12345678"}
+	created, err := local.CreateCustomer(customer)
+	if err != nil {
+		t.Fatalf("Error creating the customer: %v", err)
+	}
+	if created.ID != "123-abc" || created.Identification.Number != "12345678" {
+		t.Errorf("Unexpected customer created: %+v", created)
+	}
+
+	update := &mercadopago.Customer{Phone: mercadopago.Phone{AreaCode: "11", Number: "55555555"}}
+	updated, err := local.UpdateCustomer(created.ID, update)
+	if err != nil {
+		t.Fatalf("Error updating the customer: %v", err)
+	}
+	if updated.Phone.Number != "55555555" || updated.FirstName != "Jon" {
+		t.Errorf("Expected phone to be updated keeping the other fields and got %+v", updated)
+	}
+
+	found, err := local.SearchCustomers("jonsnow@winterfell.north")
+	if err != nil {
+		t.Fatalf("Error searching the customer: %v", err)
+	}
+	if found.Paging.Total != 1 || found.Results[0].ID != "123-abc" {
+		t.Errorf("Expected the customer to be found by email and got %+v", found)
+	}
+
+	if _, err := local.DeleteCustomer(created.ID); err != nil {
+		t.Fatalf("Error deleting the customer: %v", err)
+	}
+}
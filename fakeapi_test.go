@@ -0,0 +1,34 @@
+package mercadopago_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gpascual2/mp-sdk-go"
+)
+
+// fakeTokenResponse is the access token issued by the fake MP API
+const fakeTokenResponse = `{"access_token":"APP_USR-local","expires_in":21600}`
+
+// newFakeAPI starts a fake MP API that is closed when the test ends.
+// Access token requests are answered with fakeTokenResponse, the rest are passed to handler.
+func newFakeAPI(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, fakeTokenResponse)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newFakeMP returns a sandbox MP instance sending its calls to the fake MP API at baseURL
+func newFakeMP(baseURL string, opts ...mercadopago.Option) *mercadopago.MP {
+	opts = append([]mercadopago.Option{mercadopago.WithBaseURL(baseURL)}, opts...)
+	return mercadopago.NewMP("id", "secret", "token", true, false, opts...)
+}
@@ -0,0 +1,45 @@
+package mercadopago
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// FlexID is an identifier the MP API sends either as string or as number.
+// It is always encoded as a JSON string.
+type FlexID string
+
+// UnmarshalJSON accepts both JSON strings and numbers
+func (id *FlexID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*id = ""
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*id = FlexID(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("mercadopago: invalid ID %s", data)
+	}
+	*id = FlexID(n.String())
+	return nil
+}
+
+// MarshalJSON encodes the ID as a JSON string
+func (id FlexID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(id))
+}
+
+// String returns the ID as string
+func (id FlexID) String() string {
+	return string(id)
+}
+
+// Int64 returns the ID as number, failing if it is not numeric
+func (id FlexID) Int64() (int64, error) {
+	return strconv.ParseInt(string(id), 10, 64)
+}
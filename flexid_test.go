@@ -0,0 +1,46 @@
+package mercadopago_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/gpascual2/mp-sdk-go"
+)
+
+// TestFlexID - IDs sent either as string or number should be decoded
+func TestFlexID(t *testing.T) {
+	fmt.Println("mp_test : FlexID")
+	var ids []mercadopago.FlexID
+	if err := json.Unmarshal([]byte(`["123-abc", 130379930, null]`), &ids); err != nil {
+		t.Fatalf("Error decoding the IDs: %v", err)
+	}
+	if ids[0] != "123-abc" || ids[1] != "130379930" || ids[2] != "" {
+		t.Errorf("Unexpected IDs decoded: %v", ids)
+	}
+	if n, err := ids[1].Int64(); err != nil || n != 130379930 {
+		t.Errorf("Expected numeric ID 130379930 and got %d (%v)", n, err)
+	}
+	encoded, _ := json.Marshal(ids[1])
+	if string(encoded) != `"130379930"` {
+		t.Errorf("Expected ID to be encoded as string and got %s", encoded)
+	}
+}
+
+// TestPaymentFlexIDs - Payer, order and issuer IDs should be decoded whatever their JSON type
+func TestPaymentFlexIDs(t *testing.T) {
+	fmt.Println("mp_test : PaymentFlexIDs")
+	payloads := []string{
+		`{"id":1,"payer":{"id":"123456-abcdef"},"order":{"type":"mercadopago","id":987654321},"issuer_id":"310"}`,
+		`{"id":1,"payer":{"id":123456},"order":{"type":"mercadopago","id":"987654321"},"issuer_id":310}`,
+	}
+	for _, payload := range payloads {
+		payment := mercadopago.Payment{}
+		if err := json.Unmarshal([]byte(payload), &payment); err != nil {
+			t.Fatalf("Error decoding payment %s: %v", payload, err)
+		}
+		if payment.Payer.ID == "" || payment.Order.ID != "987654321" || payment.IssuerID != "310" {
+			t.Errorf("Unexpected IDs decoded from %s: payer %q, order %q, issuer %q", payload, payment.Payer.ID, payment.Order.ID, payment.IssuerID)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package mercadopago
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// RequestOption configures a single API call
+type RequestOption func(*requestOptions)
+
+type requestOptions struct {
+	idempotencyKey string
+	query          url.Values
+}
+
+func newRequestOptions(opts []RequestOption) *requestOptions {
+	ro := &requestOptions{}
+	for _, opt := range opts {
+		opt(ro)
+	}
+	return ro
+}
+
+// withQuery adds query string parameters to a JSON API call
+func withQuery(query url.Values) RequestOption {
+	return func(ro *requestOptions) {
+		if ro.query == nil {
+			ro.query = url.Values{}
+		}
+		for k, values := range query {
+			for _, v := range values {
+				ro.query.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithIdempotencyKey sets the X-Idempotency-Key header of a mutating call,
+// so it can be safely sent again without duplicating its effects
+func WithIdempotencyKey(key string) RequestOption {
+	return func(ro *requestOptions) {
+		ro.idempotencyKey = key
+	}
+}
+
+// WithNewIdempotencyKey sets a randomly generated idempotency key on a mutating call.
+// The key used can be recovered from the returned error with ErrorIdempotencyKey.
+func WithNewIdempotencyKey() RequestOption {
+	return WithIdempotencyKey(NewIdempotencyKey())
+}
+
+// NewIdempotencyKey returns a random UUID (v4) to be used as idempotency key
+func NewIdempotencyKey() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// IdempotentRequestError wraps a transport error of a call sent with an idempotency key
+type IdempotentRequestError struct {
+	IdempotencyKey string
+	Err            error
+}
+
+func (e *IdempotentRequestError) Error() string {
+	return fmt.Sprintf("mercadopago: request with idempotency key %s failed: %v", e.IdempotencyKey, e.Err)
+}
+
+func (e *IdempotentRequestError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorIdempotencyKey returns the idempotency key of the call that produced err, if any.
+// Retrying the call with the same key is safe.
+func ErrorIdempotencyKey(err error) (string, bool) {
+	var ire *IdempotentRequestError
+	if errors.As(err, &ire) {
+		return ire.IdempotencyKey, true
+	}
+	var mpe *MPError
+	if errors.As(err, &mpe) && mpe.IdempotencyKey != "" {
+		return mpe.IdempotencyKey, true
+	}
+	return "", false
+}
@@ -0,0 +1,50 @@
+package mercadopago_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gpascual2/mp-sdk-go"
+)
+
+// TestIdempotencyKey - The idempotency key should be sent to the API and exposed on errors
+func TestIdempotencyKey(t *testing.T) {
+	fmt.Println("mp_test : IdempotencyKey")
+	var keys []string
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("X-Idempotency-Key"))
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	local := newFakeMP(server.URL, mercadopago.WithRetryPolicy(testRetryPolicy))
+	_, err := local.CreatePayment(&mercadopago.Payment{}, mercadopago.WithIdempotencyKey("key-1"))
+	if err == nil {
+		t.Fatalf("Expected an error creating the payment")
+	}
+	if len(keys) != 3 || keys[0] != "key-1" || keys[2] != "key-1" {
+		t.Errorf("Expected 3 attempts with key-1 and got %v", keys)
+	}
+	if key, ok := mercadopago.ErrorIdempotencyKey(err); !ok || key != "key-1" {
+		t.Errorf("Expected error idempotency key to be key-1 and got %s", key)
+	}
+}
+
+// TestIdempotencyKeyTransportError - The idempotency key should be exposed on transport errors
+func TestIdempotencyKeyTransportError(t *testing.T) {
+	fmt.Println("mp_test : IdempotencyKeyTransportError")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	local := newFakeMP(server.URL)
+	_, err := local.CreatePayment(&mercadopago.Payment{}, mercadopago.WithNewIdempotencyKey())
+	var ire *mercadopago.IdempotentRequestError
+	if !errors.As(err, &ire) {
+		t.Fatalf("Expected error to be an IdempotentRequestError and got %v", err)
+	}
+	if len(ire.IdempotencyKey) != 36 {
+		t.Errorf("Expected a generated UUID as idempotency key and got %s", ire.IdempotencyKey)
+	}
+}
@@ -0,0 +1,95 @@
+package mercadopago
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+)
+
+// ErrInvalidIdentification is returned when an identification does not match the document types catalog
+var ErrInvalidIdentification = errors.New("mercadopago: invalid identification")
+
+// ListIdentificationTypes Get the personal document types of the country of the account
+//	@return json
+func (mp *MP) ListIdentificationTypes() ([]IdentificationType, error) {
+	return mp.ListIdentificationTypesWithContext(context.Background())
+}
+
+// ListIdentificationTypesWithContext Get the personal document types of the country of the account
+//	@param ctx
+//	@return json
+func (mp *MP) ListIdentificationTypesWithContext(ctx context.Context) ([]IdentificationType, error) {
+	res := []IdentificationType{}
+	uri := fmt.Sprintf("/v1/identification_types")
+	// Call GET method
+	r, err := mp.jget(ctx, uri, nil, customAuth)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	// Check response status
+	if r.StatusCode != 200 {
+		return nil, newResponseError(r)
+	}
+	// Read response Body and unmarshall content
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Validate checks the length and characters of a document number of this type
+func (t *IdentificationType) Validate(number string) error {
+	if t.MinLength > 0 && len(number) < t.MinLength {
+		return fmt.Errorf("%w: %s number must have at least %d characters", ErrInvalidIdentification, t.ID, t.MinLength)
+	}
+	if t.MaxLength > 0 && len(number) > t.MaxLength {
+		return fmt.Errorf("%w: %s number must have at most %d characters", ErrInvalidIdentification, t.ID, t.MaxLength)
+	}
+	if t.Type == "number" {
+		for _, c := range number {
+			if c < '0' || c > '9' {
+				return fmt.Errorf("%w: %s number must only contain digits", ErrInvalidIdentification, t.ID)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateIdentification checks an identification against a document types catalog,
+// as obtained from ListIdentificationTypes
+func ValidateIdentification(types []IdentificationType, id Identification) error {
+	for i := range types {
+		if types[i].ID == id.Type {
+			return types[i].Validate(id.Number)
+		}
+	}
+	return fmt.Errorf("%w: unknown document type %q", ErrInvalidIdentification, id.Type)
+}
+
+// ValidatePayerIdentification checks the payer identification of a payment against the
+// document types catalog, so it can be fixed before calling CreatePayment
+//	@param payment
+//	@return error
+func (mp *MP) ValidatePayerIdentification(payment *Payment) error {
+	return mp.ValidatePayerIdentificationWithContext(context.Background(), payment)
+}
+
+// ValidatePayerIdentificationWithContext checks the payer identification of a payment against the
+// document types catalog, so it can be fixed before calling CreatePayment
+//	@param ctx
+//	@param payment
+//	@return error
+func (mp *MP) ValidatePayerIdentificationWithContext(ctx context.Context, payment *Payment) error {
+	types, err := mp.ListIdentificationTypesWithContext(ctx)
+	if err != nil {
+		return err
+	}
+	return ValidateIdentification(types, payment.Payer.Identification)
+}
@@ -0,0 +1,10 @@
+package mercadopago
+
+// IdentificationType is the data struct for personal document types MP API
+type IdentificationType struct {
+	ID        string `json:"id,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Type      string `json:"type,omitempty"` // "number" or "string"
+	MinLength int    `json:"min_length,omitempty"`
+	MaxLength int    `json:"max_length,omitempty"`
+}
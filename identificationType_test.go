@@ -0,0 +1,44 @@
+package mercadopago_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gpascual2/mp-sdk-go"
+)
+
+// TestValidatePayerIdentification - A payer identification should be checked against the document types catalog
+func TestValidatePayerIdentification(t *testing.T) {
+	fmt.Println("mp_test : ValidatePayerIdentification")
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/identification_types" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		fmt.Fprint(w, `[{"id":"DNI","name":"DNI","type":"number","min_length":7,"max_length":8},{"id":"Otro","name":"Otro","type":"string","min_length":5,"max_length":20}]`)
+	})
+
+	local := newFakeMP(server.URL)
+	cases := []struct {
+		id    mercadopago.Identification
+		valid bool
+	}{
+		{mercadopago.Identification{Type: "DNI", Number: "12345678"}, true},
+		{mercadopago.Identification{Type: "DNI", Number: "123"}, false},
+		{mercadopago.Identification{Type: "DNI", Number: "1234567A"}, false},
+		{mercadopago.Identification{Type: "Otro", Number: "AB-12345"}, true},
+		{mercadopago.Identification{Type: "CPF", Number: "12345678909"}, false},
+	}
+	for _, c := range cases {
+		payment := &mercadopago.Payment{}
+		payment.Payer.Identification = c.id
+		err := local.ValidatePayerIdentification(payment)
+		if c.valid && err != nil {
+			t.Errorf("Expected %v to be valid and got %v", c.id, err)
+		}
+		if !c.valid && !errors.Is(err, mercadopago.ErrInvalidIdentification) {
+			t.Errorf("Expected %v to be invalid and got %v", c.id, err)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package mercadopago
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+)
+
+// GetMerchantOrder Get a merchant order by ID
+//	@param id
+//	@return json
+func (mp *MP) GetMerchantOrder(id string) (*MerchantOrder, error) {
+	return mp.GetMerchantOrderWithContext(context.Background(), id)
+}
+
+// GetMerchantOrderWithContext Get a merchant order by ID
+//	@param ctx
+//	@param id
+//	@return json
+func (mp *MP) GetMerchantOrderWithContext(ctx context.Context, id string) (*MerchantOrder, error) {
+	res := &MerchantOrder{}
+	uri := fmt.Sprintf("/merchant_orders/%v", url.PathEscape(id))
+	// Call GET method
+	r, err := mp.get(ctx, uri, nil, basicAuth)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	// Check response status
+	if r.StatusCode != 200 {
+		return nil, newResponseError(r)
+	}
+	// Read response Body and unmarshall content
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SearchMerchantOrders Search for merchant orders using a filter set
+//	@param filters
+//	@return json
+func (mp *MP) SearchMerchantOrders(filters *MerchantOrderSearchFilters) (*MerchantOrderSearch, error) {
+	return mp.SearchMerchantOrdersWithContext(context.Background(), filters)
+}
+
+// SearchMerchantOrdersWithContext Search for merchant orders using a filter set
+//	@param ctx
+//	@param filters
+//	@return json
+func (mp *MP) SearchMerchantOrdersWithContext(ctx context.Context, filters *MerchantOrderSearchFilters) (*MerchantOrderSearch, error) {
+	res := &MerchantOrderSearch{}
+	uri := fmt.Sprintf("/merchant_orders/search")
+	// Call GET method
+	r, err := mp.get(ctx, uri, filters.values(), basicAuth)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	// Check response status
+	if r.StatusCode != 200 {
+		return nil, newResponseError(r)
+	}
+	// Read response Body and unmarshall content
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateMerchantOrder Creates a merchant order
+//	@param order
+//	@param opts
+//	@return json
+func (mp *MP) CreateMerchantOrder(order *MerchantOrder, opts ...RequestOption) (*MerchantOrder, error) {
+	return mp.CreateMerchantOrderWithContext(context.Background(), order, opts...)
+}
+
+// CreateMerchantOrderWithContext Creates a merchant order
+//	@param ctx
+//	@param order
+//	@param opts
+//	@return json
+func (mp *MP) CreateMerchantOrderWithContext(ctx context.Context, order *MerchantOrder, opts ...RequestOption) (*MerchantOrder, error) {
+	res := &MerchantOrder{}
+	uri := fmt.Sprintf("/merchant_orders")
+	// Call POST method
+	r, err := mp.post(ctx, uri, order, basicAuth, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	// Check response status
+	if r.StatusCode != 200 && r.StatusCode != 201 {
+		return nil, newResponseError(r)
+	}
+	// Read response Body and unmarshall content
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// UpdateMerchantOrder Updates a merchant order. Only the fields set on order are changed.
+//	@param id
+//	@param order
+//	@param opts
+//	@return json
+func (mp *MP) UpdateMerchantOrder(id string, order *MerchantOrder, opts ...RequestOption) (*MerchantOrder, error) {
+	return mp.UpdateMerchantOrderWithContext(context.Background(), id, order, opts...)
+}
+
+// UpdateMerchantOrderWithContext Updates a merchant order. Only the fields set on order are changed.
+//	@param ctx
+//	@param id
+//	@param order
+//	@param opts
+//	@return json
+func (mp *MP) UpdateMerchantOrderWithContext(ctx context.Context, id string, order *MerchantOrder, opts ...RequestOption) (*MerchantOrder, error) {
+	res := &MerchantOrder{}
+	uri := fmt.Sprintf("/merchant_orders/%v", url.PathEscape(id))
+	data, err := partialUpdate(order)
+	if err != nil {
+		return nil, err
+	}
+	// Call PUT method
+	r, err := mp.put(ctx, uri, data, basicAuth, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	// Check response status
+	if r.StatusCode != 200 && r.StatusCode != 201 {
+		return nil, newResponseError(r)
+	}
+	// Read response Body and unmarshall content
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
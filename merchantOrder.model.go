@@ -0,0 +1,167 @@
+package mercadopago
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// MerchantOrder is the data struct for merchant orders MP API.
+// Payments made through a checkout preference are grouped in a merchant order.
+type MerchantOrder struct {
+	ID                int    `json:"id,omitempty"`
+	PreferenceID      string `json:"preference_id,omitempty"`
+	ApplicationID     string `json:"application_id,omitempty"`
+	Status            string `json:"status,omitempty"`
+	OrderStatus       string `json:"order_status,omitempty"`
+	SiteID            string `json:"site_id,omitempty"`
+	SponsorID         int    `json:"sponsor_id,omitempty"`
+	ExternalReference string `json:"external_reference,omitempty"`
+	AdditionalInfo    string `json:"additional_info,omitempty"`
+	NotificationURL   string `json:"notification_url,omitempty"`
+	Marketplace       string `json:"marketplace,omitempty"`
+	DateCreated       string `json:"date_created,omitempty"`
+	LastUpdated       string `json:"last_updated,omitempty"`
+	Cancelled         bool   `json:"cancelled,omitempty"`
+	Payer             struct {
+		ID       int    `json:"id,omitempty"`
+		Nickname string `json:"nickname,omitempty"`
+	} `json:"payer,omitempty"`
+	Collector struct {
+		ID       int    `json:"id,omitempty"`
+		Nickname string `json:"nickname,omitempty"`
+	} `json:"collector,omitempty"`
+	Items          []Item                  `json:"items,omitempty"`
+	Payments       []MerchantOrderPayment  `json:"payments,omitempty"`
+	Shipments      []MerchantOrderShipment `json:"shipments,omitempty"`
+	TotalAmount    Amount                  `json:"total_amount,omitempty"`
+	PaidAmount     Amount                  `json:"paid_amount,omitempty"`
+	RefundedAmount Amount                  `json:"refunded_amount,omitempty"`
+	ShippingCost   Amount                  `json:"shipping_cost,omitempty"`
+}
+
+// MerchantOrderPayment is the summary of a payment of a merchant order.
+// The full Payment can be obtained with GetPayment using its ID.
+type MerchantOrderPayment struct {
+	ID                int    `json:"id,omitempty"`
+	TransactionAmount Amount `json:"transaction_amount,omitempty"`
+	TotalPaidAmount   Amount `json:"total_paid_amount,omitempty"`
+	ShippingCost      Amount `json:"shipping_cost,omitempty"`
+	AmountRefunded    Amount `json:"amount_refunded,omitempty"`
+	CurrencyID        string `json:"currency_id,omitempty"`
+	Status            string `json:"status,omitempty"`
+	StatusDetail      string `json:"status_detail,omitempty"`
+	OperationType     string `json:"operation_type,omitempty"`
+	DateApproved      string `json:"date_approved,omitempty"`
+	DateCreated       string `json:"date_created,omitempty"`
+	LastModified      string `json:"last_modified,omitempty"`
+}
+
+// MerchantOrderShipment is a shipment of a merchant order
+type MerchantOrderShipment struct {
+	ID              int    `json:"id,omitempty"`
+	ShipmentType    string `json:"shipment_type,omitempty"`
+	ShippingType    string `json:"shipping_type,omitempty"`
+	ShippingMode    string `json:"shipping_mode,omitempty"`
+	PickingType     string `json:"picking_type,omitempty"`
+	Status          string `json:"status,omitempty"`
+	Substatus       string `json:"substatus,omitempty"`
+	DateCreated     string `json:"date_created,omitempty"`
+	LastModified    string `json:"last_modified,omitempty"`
+	DateFirstPrint  string `json:"date_first_printed,omitempty"`
+	ServiceID       int    `json:"service_id,omitempty"`
+	SenderID        int    `json:"sender_id,omitempty"`
+	ReceiverID      int    `json:"receiver_id,omitempty"`
+	ReceiverAddress struct {
+		ZipCode      string `json:"zip_code,omitempty"`
+		StreetName   string `json:"street_name,omitempty"`
+		StreetNumber string `json:"street_number,omitempty"`
+		Floor        string `json:"floor,omitempty"`
+		Apartment    string `json:"apartment,omitempty"`
+	} `json:"receiver_address,omitempty"`
+}
+
+// IsPaid reports whether the approved payments cover the total amount of the order
+func (mo *MerchantOrder) IsPaid() bool {
+	if mo.OrderStatus != "" {
+		return mo.OrderStatus == "paid"
+	}
+	var paid Amount
+	for _, p := range mo.Payments {
+		if p.Status == PaymentStatusApproved {
+			paid = paid.Add(p.TransactionAmount.Sub(p.AmountRefunded))
+		}
+	}
+	return mo.TotalAmount > 0 && paid >= mo.TotalAmount
+}
+
+// IsShipped reports whether all the shipments of the order have been shipped or delivered
+func (mo *MerchantOrder) IsShipped() bool {
+	if len(mo.Shipments) == 0 {
+		return false
+	}
+	for _, s := range mo.Shipments {
+		if s.Status != "shipped" && s.Status != "delivered" {
+			return false
+		}
+	}
+	return true
+}
+
+// MerchantOrderSearchFilters are the criteria to search merchant orders
+type MerchantOrderSearchFilters struct {
+	Status            string
+	PreferenceID      string
+	ApplicationID     string
+	PayerID           int
+	SponsorID         int
+	ExternalReference string
+	SiteID            string
+	DateCreatedFrom   time.Time // Zero value means no lower bound
+	DateCreatedTo     time.Time // Zero value means no upper bound
+	Limit             int
+	Offset            int
+}
+
+// MerchantOrderSearch is the data struct for merchant order search results
+type MerchantOrderSearch struct {
+	Elements   []MerchantOrder `json:"elements,omitempty"`
+	NextOffset int             `json:"next_offset,omitempty"`
+	Total      int             `json:"total,omitempty"`
+}
+
+func (f *MerchantOrderSearchFilters) values() *url.Values {
+	values := &url.Values{}
+	if f == nil {
+		return values
+	}
+	set := func(key string, value string) {
+		if value != "" {
+			values.Set(key, value)
+		}
+	}
+	set("status", f.Status)
+	set("preference_id", f.PreferenceID)
+	set("application_id", f.ApplicationID)
+	set("external_reference", f.ExternalReference)
+	set("site_id", f.SiteID)
+	if f.PayerID != 0 {
+		values.Set("payer_id", strconv.Itoa(f.PayerID))
+	}
+	if f.SponsorID != 0 {
+		values.Set("sponsor_id", strconv.Itoa(f.SponsorID))
+	}
+	if !f.DateCreatedFrom.IsZero() {
+		values.Set("date_created_from", f.DateCreatedFrom.Format(searchDateFormat))
+	}
+	if !f.DateCreatedTo.IsZero() {
+		values.Set("date_created_to", f.DateCreatedTo.Format(searchDateFormat))
+	}
+	if f.Limit > 0 {
+		values.Set("limit", strconv.Itoa(f.Limit))
+	}
+	if f.Offset > 0 {
+		values.Set("offset", strconv.Itoa(f.Offset))
+	}
+	return values
+}
@@ -0,0 +1,65 @@
+package mercadopago_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gpascual2/mp-sdk-go"
+)
+
+const merchantOrderJSON = `{
+	"id": 1234567,
+	"preference_id": "pref-1",
+	"status": "closed",
+	"order_status": "paid",
+	"external_reference": "ExRef",
+	"items": [{"id": "Item1_ID", "title": "Item1_title", "quantity": 1, "currency_id": "ARS", "unit_price": 10.2}],
+	"payments": [{"id": 8262805, "transaction_amount": 10.2, "status": "approved"}],
+	"shipments": [{"id": 42, "status": "delivered"}],
+	"total_amount": 10.2,
+	"paid_amount": 10.2
+}`
+
+// TestGetMerchantOrder - A merchant order should be obtained from MercadoPago API
+func TestGetMerchantOrder(t *testing.T) {
+	fmt.Println("mp_test : GetMerchantOrder")
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/merchant_orders/1234567" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		fmt.Fprint(w, merchantOrderJSON)
+	})
+
+	local := newFakeMP(server.URL)
+	mo, err := local.GetMerchantOrder("1234567")
+	if err != nil {
+		t.Fatalf("Error getting the merchant order: %v", err)
+	}
+	if mo.Items[0].ID != "Item1_ID" || mo.Payments[0].ID != 8262805 {
+		t.Errorf("Expected merchant order item and payment to be decoded and got %+v", mo)
+	}
+	if !mo.IsPaid() || !mo.IsShipped() {
+		t.Errorf("Expected merchant order to be paid and shipped")
+	}
+}
+
+// TestSearchMerchantOrders - Merchant orders matching a filter set should be obtained from MercadoPago API
+func TestSearchMerchantOrders(t *testing.T) {
+	fmt.Println("mp_test : SearchMerchantOrders")
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("preference_id") != "pref-1" {
+			t.Errorf("Unexpected search filters %v", r.URL.Query())
+		}
+		fmt.Fprintf(w, `{"elements":[%s],"next_offset":1,"total":1}`, merchantOrderJSON)
+	})
+
+	local := newFakeMP(server.URL)
+	res, err := local.SearchMerchantOrders(&mercadopago.MerchantOrderSearchFilters{PreferenceID: "pref-1"})
+	if err != nil {
+		t.Fatalf("Error searching the merchant orders: %v", err)
+	}
+	if res.Total != 1 || res.Elements[0].ID != 1234567 {
+		t.Errorf("Expected a single merchant order and got %+v", res)
+	}
+}
@@ -2,8 +2,10 @@ package mercadopago
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
@@ -11,6 +13,8 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
 // General API configuration values
@@ -22,14 +26,57 @@ const (
 	MIMEForm    string = "application/x-www-form-urlencoded"
 )
 
-// MP is the implementation to consume Mercado Pago API services
+// MP is the implementation to consume Mercado Pago API services.
+// It is safe for concurrent use by multiple goroutines, as long as its fields are not changed once it is shared.
+// BasicAccessToken is updated by the SDK when the token is renewed, so it must not be read or set
+// by callers once the MP is in use: use GetAccessToken and SetAccessToken instead.
 type MP struct {
 	CustomAccessToken string
 	BasicAccessToken  string
 	ClientID          string
+	PublicKey         string
 	clientSecret      string
 	Sandbox           bool
 	Debug             bool
+	httpClient        *http.Client
+	baseURL           string
+	retryPolicy       RetryPolicy
+	authStrategy      AuthStrategy
+	tokenMu           sync.Mutex // Guards BasicAccessToken and token
+	token             *Token
+	tokenStore        TokenStore
+	paymentMethods    paymentMethodsCache
+}
+
+// Option configures optional settings of an MP instance
+type Option func(*MP)
+
+// WithHTTPClient sets the HTTP client used to reach the MP API (timeouts, proxies, etc.)
+func WithHTTPClient(client *http.Client) Option {
+	return func(mp *MP) {
+		mp.httpClient = client
+	}
+}
+
+// WithTransport sets the HTTP transport used to reach the MP API
+func WithTransport(transport http.RoundTripper) Option {
+	return func(mp *MP) {
+		mp.httpClient = &http.Client{Transport: transport}
+	}
+}
+
+// WithPublicKey sets the public key, used to create card tokens
+func WithPublicKey(publicKey string) Option {
+	return func(mp *MP) {
+		mp.PublicKey = publicKey
+	}
+}
+
+// WithBaseURL overrides the MP API base URL (defaults to APIBaseURL)
+func WithBaseURL(baseURL string) Option {
+	return func(mp *MP) {
+		mp.baseURL = baseURL
+	}
 }
 
 // TokenResponse is the structure of data obtained from the MP Auth Token service
@@ -44,189 +91,280 @@ type TokenResponse struct {
 }
 
 // NewMP returns a new instance of the MP service library
-func NewMP(clientID string, clientSecret string, customAccessToken string, sandbox bool, debug bool) MP {
-	mp := MP{}
+func NewMP(clientID string, clientSecret string, customAccessToken string, sandbox bool, debug bool, opts ...Option) *MP {
+	mp := &MP{}
 	mp.BasicAccessToken = ""
 	mp.CustomAccessToken = customAccessToken
 	mp.ClientID = clientID
 	mp.clientSecret = clientSecret
 	mp.Sandbox = sandbox
 	mp.Debug = debug
+	mp.httpClient = http.DefaultClient
+	mp.baseURL = APIBaseURL
+	for _, opt := range opts {
+		opt(mp)
+	}
 	return mp
 }
 
 // GetAccessToken returns an Access Token obtained from MP API
 func (mp *MP) GetAccessToken() (string, error) {
-	if mp.BasicAccessToken == "" {
-		err := mp.obtainAccessToken()
-		if err != nil {
-			return "", err
-		}
-	}
-	return mp.BasicAccessToken, nil
+	return mp.GetAccessTokenWithContext(context.Background())
 }
 
-func (mp *MP) obtainAccessToken() error {
+// GetAccessTokenWithContext returns an Access Token obtained from MP API, bound to ctx
+func (mp *MP) GetAccessTokenWithContext(ctx context.Context) (string, error) {
+	return mp.basicAccessToken(ctx)
+}
+
+// obtainAccessToken requests a new token to the MP Auth Token service using the given grant type
+func (mp *MP) obtainAccessToken(ctx context.Context, grantType string, refreshToken string) (*Token, error) {
 	data := &url.Values{}
 	data.Set("client_id", mp.ClientID)
 	data.Add("client_secret", mp.clientSecret)
-	data.Add("grant_type", "client_credentials")
-	r, err := mp.restFormCall("POST", "/oauth/token", data, 0)
+	data.Add("grant_type", grantType)
+	if refreshToken != "" {
+		data.Add("refresh_token", refreshToken)
+	}
+	r, err := mp.restFormCall(ctx, "POST", "/oauth/token", data, noAuth)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer r.Body.Close()
 	// Check response status
 	if r.StatusCode != 200 && r.StatusCode != 201 {
-		return fmt.Errorf("Bad status received in HTTP response: %v", r.Status)
+		return nil, newResponseError(r)
 	}
 	body, err := ioutil.ReadAll(r.Body)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	var token TokenResponse
-	if err = json.Unmarshal(body, &token); err != nil {
-		return err
+	var res TokenResponse
+	if err = json.Unmarshal(body, &res); err != nil {
+		return nil, err
+	}
+	token := &Token{AccessToken: res.AccessToken, RefreshToken: res.RefreshToken}
+	if res.ExpiresIn > 0 {
+		token.ExpiresAt = time.Now().Add(time.Duration(res.ExpiresIn) * time.Second)
 	}
-	mp.BasicAccessToken = token.AccessToken
-	return nil
+	return token, nil
 }
 
 // GET HTTP method wrapper for authentication (Form)
-func (mp *MP) get(resource string, values *url.Values, auth int) (*http.Response, error) {
-	return mp.restFormCall("GET", resource, values, auth)
+func (mp *MP) get(ctx context.Context, resource string, values *url.Values, auth authMode) (*http.Response, error) {
+	return mp.restFormCall(ctx, "GET", resource, values, auth)
 }
 
 // JGET HTTP method wrapper for authentication (JSON)
-func (mp *MP) jget(resource string, data interface{}, auth int) (*http.Response, error) {
+func (mp *MP) jget(ctx context.Context, resource string, data interface{}, auth authMode) (*http.Response, error) {
 	dataBuffer := new(bytes.Buffer)
 	if data != nil {
 		json.NewEncoder(dataBuffer).Encode(data)
 	}
-	return mp.restJSONCall("GET", resource, dataBuffer, auth)
+	return mp.restJSONCall(ctx, "GET", resource, dataBuffer, auth, nil)
 }
 
 // POST HTTP method wrapper for authentication (JSON)
-func (mp *MP) post(resource string, data interface{}, auth int) (*http.Response, error) {
+func (mp *MP) post(ctx context.Context, resource string, data interface{}, auth authMode, opts ...RequestOption) (*http.Response, error) {
 	dataBuffer := new(bytes.Buffer)
 	if data != nil {
 		json.NewEncoder(dataBuffer).Encode(data)
 	}
-	return mp.restJSONCall("POST", resource, dataBuffer, auth)
+	return mp.restJSONCall(ctx, "POST", resource, dataBuffer, auth, newRequestOptions(opts))
 }
 
 // PUT HTTP method wrapper for authentication (JSON)
-func (mp *MP) put(resource string, data interface{}, auth int) (*http.Response, error) {
+func (mp *MP) put(ctx context.Context, resource string, data interface{}, auth authMode, opts ...RequestOption) (*http.Response, error) {
 	dataBuffer := new(bytes.Buffer)
 	if data != nil {
 		json.NewEncoder(dataBuffer).Encode(data)
 	}
-	return mp.restJSONCall("PUT", resource, dataBuffer, auth)
+	return mp.restJSONCall(ctx, "PUT", resource, dataBuffer, auth, newRequestOptions(opts))
+}
+
+// DELETE HTTP method wrapper for authentication (JSON)
+func (mp *MP) del(ctx context.Context, resource string, auth authMode) (*http.Response, error) {
+	return mp.restJSONCall(ctx, "DELETE", resource, new(bytes.Buffer), auth, nil)
+}
+
+// partialUpdate encodes data for a partial update: nested objects left empty are removed,
+// so the API keeps their current values instead of clearing them
+func partialUpdate(data interface{}) (map[string]interface{}, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(encoded))
+	dec.UseNumber()
+	var fields map[string]interface{}
+	if err = dec.Decode(&fields); err != nil {
+		return nil, err
+	}
+	pruneEmptyObjects(fields)
+	return fields, nil
+}
+
+func pruneEmptyObjects(fields map[string]interface{}) {
+	for k, v := range fields {
+		if obj, ok := v.(map[string]interface{}); ok {
+			pruneEmptyObjects(obj)
+			if len(obj) == 0 {
+				delete(fields, k)
+			}
+		}
+	}
 }
 
 // generic API REST call with Mercado Pago preferences
-func (mp *MP) restFormCall(method string, resource string, values *url.Values, auth int) (*http.Response, error) {
+func (mp *MP) restFormCall(ctx context.Context, method string, resource string, values *url.Values, auth authMode) (*http.Response, error) {
+	resp, accessToken, err := mp.restFormAttempt(ctx, method, resource, values, auth)
+	if err == nil && auth == basicAuth && resp.StatusCode == http.StatusUnauthorized {
+		// The token was revoked or expired ahead of time: renew it and try once more
+		resp.Body.Close()
+		mp.invalidateAccessToken(ctx, accessToken)
+		resp, _, err = mp.restFormAttempt(ctx, method, resource, values, auth)
+	}
+	return resp, err
+}
+
+// single try of a form API call, also returns the access token used
+func (mp *MP) restFormAttempt(ctx context.Context, method string, resource string, values *url.Values, auth authMode) (*http.Response, string, error) {
 	// Build resource URL
-	u, err := url.ParseRequestURI(APIBaseURL)
+	urlStr, err := mp.resourceURL(resource)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	u.Path = resource
-	urlStr := fmt.Sprintf("%v", u)
 	// If no values passed, then initialize an empty object
 	if values == nil {
 		values = &url.Values{}
 	}
-	// If authed method, then add a form entry for the MP Access Token (Basic Workflow)
-	if auth == 1 {
-		if mp.BasicAccessToken == "" {
-			err := mp.obtainAccessToken()
-			if err != nil {
-				return nil, err
-			}
+	// Get the MP Access Token for authed methods (Basic or Custom workflow)
+	accessToken, err := mp.accessToken(ctx, auth)
+	if err != nil {
+		return nil, "", err
+	}
+	// Create HTTP Request, values of GET requests are sent in the query string
+	encoded := values.Encode()
+	var body io.Reader
+	if method == "GET" {
+		if encoded != "" {
+			urlStr += "?" + encoded
 		}
-		values.Add("access_token", mp.BasicAccessToken)
+	} else {
+		body = strings.NewReader(encoded)
 	}
-	// If authed method, then add a form entry for the MP Access Token (Custom Workflow)
-	if auth == 2 {
-		values.Add("access_token", mp.CustomAccessToken)
+	r, err := http.NewRequestWithContext(ctx, method, urlStr, body)
+	if err != nil {
+		return nil, "", err
 	}
-	// Create HTTP Request
-	r, _ := http.NewRequest(method, urlStr, bytes.NewBufferString(values.Encode()))
-	if err == nil {
-		r.Header.Add("Content-Length", strconv.Itoa(len(values.Encode())))
-		r.Header.Set("User-Agent", MPUserAgent)
-		r.Header.Add("accept", MIMEJSON)
+	if body != nil {
+		r.Header.Add("Content-Length", strconv.Itoa(len(encoded)))
 		r.Header.Add("content-type", MIMEForm)
-		// DEBUG only - Print full request
-		if mp.Debug {
-			debug(httputil.DumpRequestOut(r, true))
-		}
 	}
-	client := &http.Client{}
-	resp, respErr := client.Do(r)
+	mp.authorize(r, accessToken)
+	r.Header.Set("User-Agent", MPUserAgent)
+	r.Header.Add("accept", MIMEJSON)
+	// DEBUG only - Print full request
+	if mp.Debug {
+		debug(httputil.DumpRequestOut(r, true))
+	}
+	resp, respErr := mp.do(r)
 	if respErr == nil {
 		// DEBUG only - Print full response
 		if mp.Debug {
 			debug(httputil.DumpResponse(resp, true))
 		}
 	}
-	return resp, respErr
+	return resp, accessToken, respErr
 }
 
 // generic API REST call with Mercado Pago preferences
-func (mp *MP) restJSONCall(method string, resource string, data *bytes.Buffer, auth int) (*http.Response, error) {
+func (mp *MP) restJSONCall(ctx context.Context, method string, resource string, data *bytes.Buffer, auth authMode, ro *requestOptions) (*http.Response, error) {
+	body := data.Bytes()
+	resp, accessToken, err := mp.restJSONAttempt(ctx, method, resource, body, auth, ro)
+	if err == nil && auth == basicAuth && resp.StatusCode == http.StatusUnauthorized {
+		// The token was revoked or expired ahead of time: renew it and try once more
+		resp.Body.Close()
+		mp.invalidateAccessToken(ctx, accessToken)
+		resp, _, err = mp.restJSONAttempt(ctx, method, resource, body, auth, ro)
+	}
+	return resp, err
+}
+
+// single try of a JSON API call, also returns the access token used
+func (mp *MP) restJSONAttempt(ctx context.Context, method string, resource string, data []byte, auth authMode, ro *requestOptions) (*http.Response, string, error) {
 	// Build resource URL
-	u, err := url.ParseRequestURI(APIBaseURL)
+	urlStr, err := mp.resourceURL(resource)
 	if err != nil {
-		return nil, err
-	}
-	u.Path = resource
-	urlStr := fmt.Sprintf("%v", u)
-	// If authed method, then add a form entry for the MP Access Token (Basic Workflow)
-	if auth == 1 {
-		if mp.BasicAccessToken == "" {
-			err := mp.obtainAccessToken()
-			if err != nil {
-				return nil, err
-			}
-		}
-		if strings.Contains(urlStr, "?") {
-			urlStr += "&access_token=" + mp.BasicAccessToken
-		} else {
-			urlStr += "?access_token=" + mp.BasicAccessToken
-		}
+		return nil, "", err
 	}
-	// If authed method, then add a form entry for the MP Access Token (Custom Workflow)
-	if auth == 2 {
-		if strings.Contains(urlStr, "?") {
-			urlStr += "&access_token=" + mp.CustomAccessToken
-		} else {
-			urlStr += "?access_token=" + mp.CustomAccessToken
-		}
+	// Get the MP Access Token for authed methods (Basic or Custom workflow)
+	accessToken, err := mp.accessToken(ctx, auth)
+	if err != nil {
+		return nil, "", err
 	}
 
 	// Create HTTP Request
-	r, err := http.NewRequest(method, urlStr, data)
-	if err == nil {
-		r.Header.Add("Content-Length", strconv.Itoa(data.Len()))
-		r.Header.Set("User-Agent", MPUserAgent)
-		r.Header.Set("Content-Type", MIMEJSON)
-		r.Header.Add("Accept", MIMEJSON)
-		// DEBUG only - Print full request
-		if mp.Debug {
-			debug(httputil.DumpRequestOut(r, true))
-		}
+	if ro != nil && len(ro.query) > 0 {
+		urlStr += "?" + ro.query.Encode()
+	}
+	r, err := http.NewRequestWithContext(ctx, method, urlStr, bytes.NewReader(data))
+	if err != nil {
+		return nil, "", err
+	}
+	r.Header.Add("Content-Length", strconv.Itoa(len(data)))
+	r.Header.Set("User-Agent", MPUserAgent)
+	r.Header.Set("Content-Type", MIMEJSON)
+	r.Header.Add("Accept", MIMEJSON)
+	mp.authorize(r, accessToken)
+	if ro != nil && ro.idempotencyKey != "" {
+		r.Header.Set(idempotencyHeader, ro.idempotencyKey)
+	}
+	// DEBUG only - Print full request
+	if mp.Debug {
+		debug(httputil.DumpRequestOut(r, true))
 	}
 
-	client := &http.Client{}
-	resp, respErr := client.Do(r)
-	if respErr == nil {
-		// DEBUG only - Print full response
-		if mp.Debug {
-			debug(httputil.DumpResponse(resp, true))
+	resp, respErr := mp.do(r)
+	if respErr != nil {
+		if ro != nil && ro.idempotencyKey != "" {
+			return nil, "", &IdempotentRequestError{IdempotencyKey: ro.idempotencyKey, Err: respErr}
 		}
+		return nil, "", respErr
+	}
+	// DEBUG only - Print full response
+	if mp.Debug {
+		debug(httputil.DumpResponse(resp, true))
+	}
+	return resp, accessToken, nil
+}
+
+// client returns the HTTP client configured for this instance
+func (mp *MP) client() *http.Client {
+	if mp.httpClient == nil {
+		return http.DefaultClient
+	}
+	return mp.httpClient
+}
+
+// resourceURL builds the full URL of an API resource using the configured base URL
+func (mp *MP) resourceURL(resource string) (string, error) {
+	base := mp.baseURL
+	if base == "" {
+		base = APIBaseURL
+	}
+	u, err := url.ParseRequestURI(base)
+	if err != nil {
+		return "", err
+	}
+	// Resources are already escaped (e.g. IDs built with url.PathEscape)
+	path, err := url.PathUnescape(resource)
+	if err != nil {
+		return "", err
 	}
-	return resp, respErr
+	u.RawPath = strings.TrimSuffix(u.EscapedPath(), "/") + resource
+	u.Path = strings.TrimSuffix(u.Path, "/") + path
+	return u.String(), nil
 }
 
 func debug(data []byte, err error) {
@@ -1,14 +1,22 @@
 package mercadopago_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/gpascual2/mp-sdk-go"
 )
 
-var mp mercadopago.MP
+var mp *mercadopago.MP
 
 // This function is used for setup before executing the test functions
 func TestMain(m *testing.M) {
@@ -45,3 +53,94 @@ func TestAccessToken(t *testing.T) {
 		t.Errorf("Expected AccessToken to contain a value and is empty")
 	}
 }
+
+// TestBaseURLOption - Calls should be sent to the configured base URL using the configured HTTP client
+func TestBaseURLOption(t *testing.T) {
+	fmt.Println("mp_test : BaseURLOption")
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, fakeTokenResponse)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Timeout: 5 * time.Second}
+	local := newFakeMP(server.URL, mercadopago.WithHTTPClient(client))
+	at, err := local.GetAccessToken()
+	if err != nil {
+		t.Fatalf("Error requesting an Access Token: %v", err)
+	}
+	if at != "APP_USR-local" {
+		t.Errorf("Expected AccessToken to be APP_USR-local and got %s", at)
+	}
+	if gotPath != "/oauth/token" {
+		t.Errorf("Expected request path to be /oauth/token and got %s", gotPath)
+	}
+}
+
+// TestContextCancel - A cancelled context should abort the call before reaching the API
+func TestContextCancel(t *testing.T) {
+	fmt.Println("mp_test : ContextCancel")
+	called := false
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+
+	local := newFakeMP(server.URL)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := local.GetAccessTokenWithContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected error to be context.Canceled and got %v", err)
+	}
+	if called {
+		t.Errorf("Expected API not to be called with a cancelled context")
+	}
+}
+
+// TestConcurrentCalls - Concurrent calls should share a single Access Token request
+func TestConcurrentCalls(t *testing.T) {
+	fmt.Println("mp_test : ConcurrentCalls")
+	var tokenRequests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/oauth/token":
+			atomic.AddInt32(&tokenRequests, 1)
+			time.Sleep(10 * time.Millisecond)
+			fmt.Fprint(w, fakeTokenResponse)
+		case strings.HasPrefix(r.URL.Path, "/checkout/preferences"):
+			fmt.Fprint(w, `{"id":"pref-1"}`)
+		default:
+			fmt.Fprint(w, `{"id":1,"status":"approved"}`)
+		}
+	}))
+	defer server.Close()
+
+	local := newFakeMP(server.URL)
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := local.CreatePreference(&mercadopago.Preference{})
+			errs <- err
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := local.GetPayment("1")
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("Error on concurrent call: %v", err)
+		}
+	}
+	if n := atomic.LoadInt32(&tokenRequests); n != 1 {
+		t.Errorf("Expected a single Access Token request and got %d", n)
+	}
+}
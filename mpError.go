@@ -1,11 +1,72 @@
 package mercadopago
 
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
 // MPError is the type returned by the lib in case of errors
 type MPError struct {
-	Name    string `json:"name"`
-	Message string `json:"message"`
-	Stack   string `json:"stack"`
-	Status  int    `json:"status"`
+	Name           string  `json:"name"`
+	Message        string  `json:"message"`
+	ErrorCode      string  `json:"error"`
+	Stack          string  `json:"stack"`
+	Status         int     `json:"status"`
+	Cause          []Cause `json:"cause"`
+	RequestID      string  `json:"-"`
+	IdempotencyKey string  `json:"-"`
+}
+
+// Cause is a detail of the reasons of an MP API error
+type Cause struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+// UnmarshalJSON accepts cause codes sent either as number or string by the MP API
+func (c *Cause) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Code        json.RawMessage `json:"code"`
+		Description string          `json:"description"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	c.Description = raw.Description
+	c.Code = ""
+	if len(raw.Code) > 0 && string(raw.Code) != "null" {
+		if err := json.Unmarshal(raw.Code, &c.Code); err != nil {
+			c.Code = string(raw.Code)
+		}
+	}
+	return nil
+}
+
+func (e *MPError) Error() string {
+	msg := fmt.Sprintf("mercadopago: %d", e.Status)
+	if e.ErrorCode != "" {
+		msg += " " + e.ErrorCode
+	}
+	msg += ": " + e.Message
+	for _, c := range e.Cause {
+		msg += fmt.Sprintf(" [%s: %s]", c.Code, c.Description)
+	}
+	if e.RequestID != "" {
+		msg += " (request id " + e.RequestID + ")"
+	}
+	return msg
+}
+
+// HasCause reports whether the error contains a cause with the given code
+func (e *MPError) HasCause(code string) bool {
+	for _, c := range e.Cause {
+		if c.Code == code {
+			return true
+		}
+	}
+	return false
 }
 
 func newMercadoPagoError(message string, status int) *MPError {
@@ -23,3 +84,27 @@ func newMercadoPagoError(message string, status int) *MPError {
 	}
 	return mpe
 }
+
+// newResponseError builds an MPError from a non successful API response, decoding its body
+func newResponseError(r *http.Response) error {
+	mpe := newMercadoPagoError(r.Status, r.StatusCode)
+	body, err := ioutil.ReadAll(r.Body)
+	if err == nil && len(body) > 0 {
+		// Ignore malformed bodies, the HTTP status is still meaningful
+		json.Unmarshal(body, mpe)
+	}
+	if mpe.Status == 0 || mpe.Status != r.StatusCode {
+		mpe.Status = r.StatusCode
+	}
+	if mpe.Message == "" {
+		mpe.Message = r.Status
+	}
+	if mpe.ErrorCode == "" {
+		mpe.ErrorCode = http.StatusText(r.StatusCode)
+	}
+	mpe.RequestID = r.Header.Get("X-Request-Id")
+	if r.Request != nil {
+		mpe.IdempotencyKey = r.Request.Header.Get(idempotencyHeader)
+	}
+	return mpe
+}
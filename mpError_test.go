@@ -0,0 +1,55 @@
+package mercadopago_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gpascual2/mp-sdk-go"
+)
+
+// TestResponseError - A non successful API response should be decoded into an MPError
+func TestResponseError(t *testing.T) {
+	fmt.Println("mp_test : ResponseError")
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req-123")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message":"Invalid card_number_validation","error":"bad_request","status":400,"cause":[{"code":205,"description":"parameter cardNumber can not be null/empty"}]}`)
+	})
+
+	local := newFakeMP(server.URL)
+	_, err := local.GetPayment("1")
+	var mpe *mercadopago.MPError
+	if !errors.As(err, &mpe) {
+		t.Fatalf("Expected error to be an MPError and got %v", err)
+	}
+	if mpe.Status != http.StatusBadRequest || mpe.ErrorCode != "bad_request" {
+		t.Errorf("Expected status 400 bad_request and got %d %s", mpe.Status, mpe.ErrorCode)
+	}
+	if !mpe.HasCause("205") {
+		t.Errorf("Expected error to have cause 205 and got %v", mpe.Cause)
+	}
+	if mpe.RequestID != "req-123" {
+		t.Errorf("Expected request id req-123 and got %s", mpe.RequestID)
+	}
+}
+
+// TestResponseErrorWithoutBody - An MPError should be returned even when the API sends no body
+func TestResponseErrorWithoutBody(t *testing.T) {
+	fmt.Println("mp_test : ResponseErrorWithoutBody")
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	local := newFakeMP(server.URL)
+	_, err := local.GetPreference("1")
+	var mpe *mercadopago.MPError
+	if !errors.As(err, &mpe) {
+		t.Fatalf("Expected error to be an MPError and got %v", err)
+	}
+	if mpe.Status != http.StatusUnauthorized {
+		t.Errorf("Expected status 401 and got %d", mpe.Status)
+	}
+}
@@ -0,0 +1,192 @@
+package mercadopago
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Notification topics (types of the notified resource)
+const (
+	TopicPayment       string = "payment"
+	TopicMerchantOrder string = "merchant_order"
+)
+
+// Notifications are small, larger bodies received on the public notification endpoint are rejected
+const maxNotificationSize int64 = 1 << 20
+
+// Notification is a notification sent by MP to the NotificationURL of a preference or payment,
+// either in the legacy IPN format or as a Webhook
+type Notification struct {
+	Topic       string // Type of the notified resource, e.g. TopicPayment
+	ResourceID  string // ID of the notified resource
+	Webhook     bool   // Whether it was received in the Webhooks format
+	ID          string // Webhooks only: ID of the notification itself
+	Action      string // Webhooks only: e.g. "payment.created"
+	LiveMode    bool   // Webhooks only
+	DateCreated string // Webhooks only
+	UserID      string // Webhooks only
+	APIVersion  string // Webhooks only
+}
+
+// webhookPayload is the JSON body of Webhooks and newer IPN notifications
+type webhookPayload struct {
+	ID          json.RawMessage `json:"id"`
+	Type        string          `json:"type"`
+	Topic       string          `json:"topic"`
+	Resource    string          `json:"resource"`
+	Action      string          `json:"action"`
+	LiveMode    bool            `json:"live_mode"`
+	DateCreated string          `json:"date_created"`
+	UserID      json.RawMessage `json:"user_id"`
+	APIVersion  string          `json:"api_version"`
+	Data        struct {
+		ID json.RawMessage `json:"id"`
+	} `json:"data"`
+}
+
+// ParseNotification reads a notification from a request received from MP.
+// The request body is left readable for later handlers.
+func ParseNotification(r *http.Request) (*Notification, error) {
+	n := &Notification{}
+	var payload webhookPayload
+	if r.Body != nil {
+		body, err := ioutil.ReadAll(http.MaxBytesReader(nil, r.Body, maxNotificationSize))
+		if err != nil {
+			return nil, fmt.Errorf("mercadopago: invalid notification body: %v", err)
+		}
+		r.Body.Close()
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		if len(bytes.TrimSpace(body)) > 0 {
+			if err := json.Unmarshal(body, &payload); err != nil {
+				return nil, fmt.Errorf("mercadopago: invalid notification body: %v", err)
+			}
+		}
+	}
+	q := r.URL.Query()
+	switch {
+	case payload.Type != "" || payload.Action != "":
+		// Webhooks
+		n.Webhook = true
+		n.Topic = payload.Type
+		n.ResourceID = rawString(payload.Data.ID)
+		n.ID = rawString(payload.ID)
+		n.Action = payload.Action
+		n.LiveMode = payload.LiveMode
+		n.DateCreated = payload.DateCreated
+		n.UserID = rawString(payload.UserID)
+		n.APIVersion = payload.APIVersion
+	case q.Get("topic") != "":
+		// Legacy IPN
+		n.Topic = q.Get("topic")
+		n.ResourceID = q.Get("id")
+	case payload.Topic != "":
+		// IPN with the resource URL in the body
+		n.Topic = payload.Topic
+		n.ResourceID = payload.Resource[strings.LastIndex(payload.Resource, "/")+1:]
+	case q.Get("type") != "":
+		// Webhooks data sent in the query string only
+		n.Webhook = true
+		n.Topic = q.Get("type")
+		n.ResourceID = q.Get("data.id")
+	default:
+		return nil, fmt.Errorf("mercadopago: request is not a notification")
+	}
+	if n.ResourceID == "" && q.Get("data.id") != "" {
+		n.ResourceID = q.Get("data.id")
+	}
+	if n.ResourceID == "" && q.Get("id") != "" {
+		n.ResourceID = q.Get("id")
+	}
+	// Webhooks name merchant orders differently
+	if n.Topic == "topic_merchant_order_wh" {
+		n.Topic = TopicMerchantOrder
+	}
+	if n.ResourceID == "" {
+		return nil, fmt.Errorf("mercadopago: notification without resource id")
+	}
+	// Payments and merchant orders have numeric IDs, anything else must not reach the API paths
+	if n.Topic == TopicPayment || n.Topic == TopicMerchantOrder {
+		if _, err := strconv.ParseUint(n.ResourceID, 10, 64); err != nil {
+			return nil, fmt.Errorf("mercadopago: invalid %s id %q", n.Topic, n.ResourceID)
+		}
+	}
+	return n, nil
+}
+
+// rawString returns a JSON value sent either as string or number as a string
+func rawString(raw json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	if string(raw) == "null" {
+		return ""
+	}
+	return string(raw)
+}
+
+// NotificationHandler is an http.Handler receiving MP notifications. The notified resource
+// is fetched from the API and passed to the callback registered for its topic.
+// Callbacks returning an error make the handler answer HTTP 500, so MP sends the notification again.
+type NotificationHandler struct {
+	MP              *MP
+	OnPayment       func(ctx context.Context, n *Notification, payment *Payment) error
+	OnMerchantOrder func(ctx context.Context, n *Notification, order *MerchantOrder) error
+	// OnNotification is called for other topics, or for topics without a specific callback
+	OnNotification func(ctx context.Context, n *Notification) error
+}
+
+// NewNotificationHandler returns a notification handler resolving resources through mp
+func NewNotificationHandler(mp *MP) *NotificationHandler {
+	return &NotificationHandler{MP: mp}
+}
+
+// ServeHTTP parses the notification, fetches the resource and dispatches it to the callbacks.
+// Error details are not sent back to the caller, they are logged when the MP is in debug mode.
+func (h *NotificationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	n, err := ParseNotification(r)
+	if err != nil {
+		h.reject(w, err, http.StatusBadRequest)
+		return
+	}
+	if err := h.dispatch(r.Context(), n); err != nil {
+		h.reject(w, err, http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *NotificationHandler) reject(w http.ResponseWriter, err error, status int) {
+	if h.MP != nil && h.MP.Debug {
+		log.Printf("mercadopago: notification failed: %v", err)
+	}
+	http.Error(w, http.StatusText(status), status)
+}
+
+func (h *NotificationHandler) dispatch(ctx context.Context, n *Notification) error {
+	switch {
+	case n.Topic == TopicPayment && h.OnPayment != nil:
+		payment, err := h.MP.GetPaymentWithContext(ctx, n.ResourceID)
+		if err != nil {
+			return err
+		}
+		return h.OnPayment(ctx, n, payment)
+	case n.Topic == TopicMerchantOrder && h.OnMerchantOrder != nil:
+		order, err := h.MP.GetMerchantOrderWithContext(ctx, n.ResourceID)
+		if err != nil {
+			return err
+		}
+		return h.OnMerchantOrder(ctx, n, order)
+	case h.OnNotification != nil:
+		return h.OnNotification(ctx, n)
+	}
+	// Acknowledge notifications nobody is interested in, so MP does not send them again
+	return nil
+}
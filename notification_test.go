@@ -0,0 +1,148 @@
+package mercadopago_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gpascual2/mp-sdk-go"
+)
+
+// newNotificationAPI returns a fake MP API serving a payment and a merchant order
+func newNotificationAPI(t *testing.T) *httptest.Server {
+	return newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/payments/8262805":
+			fmt.Fprint(w, `{"id":8262805,"status":"approved"}`)
+		case "/merchant_orders/1234567":
+			fmt.Fprint(w, `{"id":1234567,"order_status":"paid"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+// TestNotificationIPN - A legacy IPN notification should be dispatched with the notified payment
+func TestNotificationIPN(t *testing.T) {
+	fmt.Println("mp_test : NotificationIPN")
+	api := newNotificationAPI(t)
+
+	var got *mercadopago.Payment
+	handler := mercadopago.NewNotificationHandler(newFakeMP(api.URL))
+	handler.OnPayment = func(ctx context.Context, n *mercadopago.Notification, payment *mercadopago.Payment) error {
+		got = payment
+		return nil
+	}
+	req := httptest.NewRequest("POST", "/notifications?topic=payment&id=8262805", nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200 and got %d: %s", w.Code, w.Body.String())
+	}
+	if got == nil || got.Status != "approved" {
+		t.Errorf("Expected the approved payment to be dispatched and got %+v", got)
+	}
+}
+
+// TestNotificationWebhook - A Webhook notification should be dispatched with the notified merchant order
+func TestNotificationWebhook(t *testing.T) {
+	fmt.Println("mp_test : NotificationWebhook")
+	api := newNotificationAPI(t)
+
+	var got *mercadopago.MerchantOrder
+	var notification *mercadopago.Notification
+	handler := mercadopago.NewNotificationHandler(newFakeMP(api.URL))
+	handler.OnMerchantOrder = func(ctx context.Context, n *mercadopago.Notification, order *mercadopago.MerchantOrder) error {
+		got, notification = order, n
+		return nil
+	}
+	body := `{"id":12345,"live_mode":false,"type":"topic_merchant_order_wh","date_created":"2017-03-01T10:04:58.396-04:00","user_id":44444,"api_version":"v1","action":"update","data":{"id":1234567}}`
+	req := httptest.NewRequest("POST", "/notifications", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200 and got %d: %s", w.Code, w.Body.String())
+	}
+	if got == nil || !got.IsPaid() {
+		t.Errorf("Expected the paid merchant order to be dispatched and got %+v", got)
+	}
+	if !notification.Webhook || notification.ID != "12345" || notification.UserID != "44444" {
+		t.Errorf("Unexpected notification data %+v", notification)
+	}
+}
+
+// TestNotificationTraversal - Resource IDs should not be able to reach other API paths
+func TestNotificationTraversal(t *testing.T) {
+	fmt.Println("mp_test : NotificationTraversal")
+	var paths []string
+	api := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.EscapedPath())
+		fmt.Fprint(w, `{"paging":{"total":0},"results":[]}`)
+	})
+
+	called := false
+	handler := mercadopago.NewNotificationHandler(newFakeMP(api.URL))
+	handler.OnPayment = func(ctx context.Context, n *mercadopago.Notification, payment *mercadopago.Payment) error {
+		called = true
+		return nil
+	}
+	targets := []string{
+		"/notifications?topic=payment&id=..%2F..%2Fv1%2Fcustomers%2Fsearch",
+		"/notifications?type=payment&data.id=1%2F..%2F..%2Fv1%2Fcustomers",
+		"/notifications?topic=merchant_order&id=..%2Fusers%2Fme",
+	}
+	for _, target := range targets {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("POST", target, nil))
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("Expected status 400 for %s and got %d", target, w.Code)
+		}
+	}
+	if called || len(paths) != 0 {
+		t.Errorf("Expected the API not to be called and got %v", paths)
+	}
+
+	// IDs are escaped when used in API paths
+	if _, err := newFakeMP(api.URL).GetPayment("../../v1/customers/search"); err != nil {
+		t.Fatalf("Error getting the payment: %v", err)
+	}
+	if len(paths) != 1 || paths[0] != "/v1/payments/..%2F..%2Fv1%2Fcustomers%2Fsearch" {
+		t.Errorf("Expected the payment ID to stay in the payment path and got %v", paths)
+	}
+}
+
+// TestNotificationErrors - Invalid notifications and failing callbacks should be reported to MP
+func TestNotificationErrors(t *testing.T) {
+	fmt.Println("mp_test : NotificationErrors")
+	api := newNotificationAPI(t)
+
+	handler := mercadopago.NewNotificationHandler(newFakeMP(api.URL))
+	handler.OnPayment = func(ctx context.Context, n *mercadopago.Notification, payment *mercadopago.Payment) error {
+		return nil
+	}
+	cases := map[string]int{
+		"/notifications":                        http.StatusBadRequest,
+		"/notifications?topic=payment&id=1":     http.StatusInternalServerError,
+		"/notifications?topic=chargebacks&id=1": http.StatusOK,
+	}
+	for target, code := range cases {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("POST", target, nil))
+		if w.Code != code {
+			t.Errorf("Expected status %d for %s and got %d", code, target, w.Code)
+		}
+		if strings.Contains(w.Body.String(), "mercadopago") {
+			t.Errorf("Expected a generic error message for %s and got %s", target, w.Body.String())
+		}
+	}
+	// Oversized bodies should not be read
+	body := `{"type":"payment","data":{"id":"8262805"},"padding":"` + strings.Repeat("x", 2<<20) + `"}`
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("POST", "/notifications", strings.NewReader(body)))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for an oversized body and got %d", w.Code)
+	}
+}
@@ -1,6 +1,7 @@
 package mercadopago
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -9,18 +10,29 @@ import (
 
 // CreatePayment Creates a payment
 //	@param preference
+//	@param opts
 //	@return json
-func (mp *MP) CreatePayment(payment *Payment) (*Payment, error) {
+func (mp *MP) CreatePayment(payment *Payment, opts ...RequestOption) (*Payment, error) {
+	return mp.CreatePaymentWithContext(context.Background(), payment, opts...)
+}
+
+// CreatePaymentWithContext Creates a payment
+//	@param ctx
+//	@param preference
+//	@param opts
+//	@return json
+func (mp *MP) CreatePaymentWithContext(ctx context.Context, payment *Payment, opts ...RequestOption) (*Payment, error) {
 	res := &Payment{}
 	uri := fmt.Sprintf("/v1/payments")
 	// Call POST method
-	r, err := mp.post(uri, payment, 2)
+	r, err := mp.post(ctx, uri, payment, customAuth, opts...)
 	if err != nil {
 		return nil, err
 	}
+	defer r.Body.Close()
 	// Check response status
 	if r.StatusCode != 200 && r.StatusCode != 201 {
-		return nil, fmt.Errorf("Bad status received in HTTP response: %v", r.Status)
+		return nil, newResponseError(r)
 	}
 	// Read response Body and unmarshall content
 	body, err := ioutil.ReadAll(r.Body)
@@ -37,16 +49,25 @@ func (mp *MP) CreatePayment(payment *Payment) (*Payment, error) {
 //	@param id
 //	@return json
 func (mp *MP) GetPayment(id string) (*Payment, error) {
+	return mp.GetPaymentWithContext(context.Background(), id)
+}
+
+// GetPaymentWithContext Get a payment by ID
+//	@param ctx
+//	@param id
+//	@return json
+func (mp *MP) GetPaymentWithContext(ctx context.Context, id string) (*Payment, error) {
 	res := &Payment{}
-	uri := fmt.Sprintf("/v1/payments/%v", id)
+	uri := fmt.Sprintf("/v1/payments/%v", url.PathEscape(id))
 	// Call GET method
-	r, err := mp.jget(uri, nil, 2)
+	r, err := mp.jget(ctx, uri, nil, customAuth)
 	if err != nil {
 		return nil, err
 	}
+	defer r.Body.Close()
 	// Check response status
 	if r.StatusCode != 200 {
-		return nil, fmt.Errorf("Bad status received in HTTP response: %v", r.Status)
+		return nil, newResponseError(r)
 	}
 	// Read response Body and unmarshall content
 	body, err := ioutil.ReadAll(r.Body)
@@ -63,18 +84,27 @@ func (mp *MP) GetPayment(id string) (*Payment, error) {
 //	@param id
 //	@return json
 func (mp *MP) GetPaymentsByRef(externalReference string) (*PaymentSearch, error) {
+	return mp.GetPaymentsByRefWithContext(context.Background(), externalReference)
+}
+
+// GetPaymentsByRefWithContext Get a payment by External Reference
+//	@param ctx
+//	@param id
+//	@return json
+func (mp *MP) GetPaymentsByRefWithContext(ctx context.Context, externalReference string) (*PaymentSearch, error) {
 	res := &PaymentSearch{}
 	uri := fmt.Sprintf("/v1/payments/search")
 	data := &url.Values{}
 	data.Add("external_reference", externalReference)
 	// Call GET method
-	r, err := mp.get(uri, data, 2)
+	r, err := mp.get(ctx, uri, data, customAuth)
 	if err != nil {
 		return nil, err
 	}
+	defer r.Body.Close()
 	// Check response status
 	if r.StatusCode != 200 {
-		return nil, fmt.Errorf("Bad status received in HTTP response: %v", r.Status)
+		return nil, newResponseError(r)
 	}
 	// Read response Body and unmarshall content
 	body, err := ioutil.ReadAll(r.Body)
@@ -91,16 +121,25 @@ func (mp *MP) GetPaymentsByRef(externalReference string) (*PaymentSearch, error)
 //	@param filters in url.Values object
 //	@return json
 func (mp *MP) PaymentsSearch(filters *url.Values) (*PaymentSearch, error) {
+	return mp.PaymentsSearchWithContext(context.Background(), filters)
+}
+
+// PaymentsSearchWithContext Search for payments using a filter set
+//	@param ctx
+//	@param filters in url.Values object
+//	@return json
+func (mp *MP) PaymentsSearchWithContext(ctx context.Context, filters *url.Values) (*PaymentSearch, error) {
 	res := &PaymentSearch{}
 	uri := fmt.Sprintf("/v1/payments/search")
 	// Call GET method
-	r, err := mp.get(uri, filters, 2)
+	r, err := mp.get(ctx, uri, filters, customAuth)
 	if err != nil {
 		return nil, err
 	}
+	defer r.Body.Close()
 	// Check response status
 	if r.StatusCode != 200 {
-		return nil, fmt.Errorf("Bad status received in HTTP response: %v", r.Status)
+		return nil, newResponseError(r)
 	}
 	// Read response Body and unmarshall content
 	body, err := ioutil.ReadAll(r.Body)
@@ -112,3 +151,203 @@ func (mp *MP) PaymentsSearch(filters *url.Values) (*PaymentSearch, error) {
 	}
 	return res, nil
 }
+
+// UpdatePayment Updates a payment with the given changes
+//	@param id
+//	@param patch: any JSON encodable value with the fields to change
+//	@param opts
+//	@return json
+func (mp *MP) UpdatePayment(id string, patch interface{}, opts ...RequestOption) (*Payment, error) {
+	return mp.UpdatePaymentWithContext(context.Background(), id, patch, opts...)
+}
+
+// UpdatePaymentWithContext Updates a payment with the given changes
+//	@param ctx
+//	@param id
+//	@param patch: any JSON encodable value with the fields to change
+//	@param opts
+//	@return json
+func (mp *MP) UpdatePaymentWithContext(ctx context.Context, id string, patch interface{}, opts ...RequestOption) (*Payment, error) {
+	res := &Payment{}
+	uri := fmt.Sprintf("/v1/payments/%v", url.PathEscape(id))
+	// Call PUT method
+	r, err := mp.put(ctx, uri, patch, customAuth, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	// Check response status
+	if r.StatusCode != 200 {
+		return nil, newResponseError(r)
+	}
+	// Read response Body and unmarshall content
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CapturePayment Captures a payment previously authorized (created with Capture false)
+//	@param id
+//	@param amount: amount to capture, 0 captures the total authorized amount
+//	@param opts
+//	@return json
+func (mp *MP) CapturePayment(id string, amount Amount, opts ...RequestOption) (*Payment, error) {
+	return mp.CapturePaymentWithContext(context.Background(), id, amount, opts...)
+}
+
+// CapturePaymentWithContext Captures a payment previously authorized (created with Capture false)
+//	@param ctx
+//	@param id
+//	@param amount: amount to capture, 0 captures the total authorized amount
+//	@param opts
+//	@return json
+func (mp *MP) CapturePaymentWithContext(ctx context.Context, id string, amount Amount, opts ...RequestOption) (*Payment, error) {
+	if amount < 0 {
+		return nil, fmt.Errorf("mercadopago: capture amount can not be negative, got %v", amount)
+	}
+	return mp.UpdatePaymentWithContext(ctx, id, &paymentCapture{Capture: true, TransactionAmount: amount}, opts...)
+}
+
+// CancelPayment Cancels a pending or in process payment
+//	@param id
+//	@param opts
+//	@return json
+func (mp *MP) CancelPayment(id string, opts ...RequestOption) (*Payment, error) {
+	return mp.CancelPaymentWithContext(context.Background(), id, opts...)
+}
+
+// CancelPaymentWithContext Cancels a pending or in process payment
+//	@param ctx
+//	@param id
+//	@param opts
+//	@return json
+func (mp *MP) CancelPaymentWithContext(ctx context.Context, id string, opts ...RequestOption) (*Payment, error) {
+	return mp.UpdatePaymentWithContext(ctx, id, &paymentStatusUpdate{Status: PaymentStatusCancelled}, opts...)
+}
+
+// SearchPayments Search for payments using a typed query
+//	@param query
+//	@return json
+func (mp *MP) SearchPayments(query *PaymentSearchQuery) (*PaymentSearch, error) {
+	return mp.SearchPaymentsWithContext(context.Background(), query)
+}
+
+// SearchPaymentsWithContext Search for payments using a typed query
+//	@param ctx
+//	@param query
+//	@return json
+func (mp *MP) SearchPaymentsWithContext(ctx context.Context, query *PaymentSearchQuery) (*PaymentSearch, error) {
+	filters, err := query.Values()
+	if err != nil {
+		return nil, err
+	}
+	return mp.PaymentsSearchWithContext(ctx, filters)
+}
+
+// PaymentIterator walks all the payments matching a search, fetching pages as needed
+//
+//	it := mp.IterPayments(ctx, query)
+//	for it.Next() {
+//		pmt := it.Payment()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type PaymentIterator struct {
+	mp       *MP
+	ctx      context.Context
+	query    PaymentSearchQuery
+	prefetch bool
+	pending  chan paymentPage
+	page     []Payment
+	current  *Payment
+	done     bool
+	err      error
+}
+
+// paymentPage is the outcome of fetching a page of payments
+type paymentPage struct {
+	res *PaymentSearch
+	err error
+}
+
+// IterPayments returns an iterator over all the payments matching query,
+// starting at query.Offset and fetching query.Limit payments per page
+func (mp *MP) IterPayments(ctx context.Context, query *PaymentSearchQuery) *PaymentIterator {
+	it := &PaymentIterator{mp: mp, ctx: ctx}
+	if query != nil {
+		it.query = *query
+	}
+	return it
+}
+
+// WithPrefetch makes the iterator fetch the next page concurrently while the current one is consumed
+func (it *PaymentIterator) WithPrefetch() *PaymentIterator {
+	it.prefetch = true
+	return it
+}
+
+// Next advances to the next payment, returning false when there are no more or on error
+func (it *PaymentIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	for len(it.page) == 0 {
+		if it.done {
+			it.current = nil
+			return false
+		}
+		if err := it.ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+		var page paymentPage
+		if it.pending != nil {
+			select {
+			case page = <-it.pending:
+			case <-it.ctx.Done():
+				it.err = it.ctx.Err()
+				return false
+			}
+			it.pending = nil
+		} else {
+			page = it.fetch(it.query)
+		}
+		if page.err != nil {
+			it.err = page.err
+			return false
+		}
+		it.page = page.res.Results
+		it.query.Offset += len(page.res.Results)
+		if len(page.res.Results) == 0 || it.query.Offset >= page.res.Paging.Total {
+			it.done = true
+		} else if it.prefetch {
+			it.pending = make(chan paymentPage, 1)
+			go func(query PaymentSearchQuery, pending chan<- paymentPage) {
+				pending <- it.fetch(query)
+			}(it.query, it.pending)
+		}
+	}
+	it.current = &it.page[0]
+	it.page = it.page[1:]
+	return true
+}
+
+func (it *PaymentIterator) fetch(query PaymentSearchQuery) paymentPage {
+	res, err := it.mp.SearchPaymentsWithContext(it.ctx, &query)
+	return paymentPage{res: res, err: err}
+}
+
+// Payment returns the current payment
+func (it *PaymentIterator) Payment() *Payment {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *PaymentIterator) Err() error {
+	return it.err
+}
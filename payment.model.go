@@ -1,5 +1,12 @@
 package mercadopago
 
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
 // Payment is the data struct for payment MP API
 type Payment struct {
 	ID               int    `json:"id,omitempty"`
@@ -10,61 +17,54 @@ type Payment struct {
 	CollectorID      int    `json:"collector_id,omitempty"`
 	OperationType    string `json:"operation_type,omitempty"`
 	Payer            struct {
-		EntityType string `json:"entity_type,omitempty"`
-		Type       string `json:"type,omitempty"`
-		// ID             string `json:"id,omitempty"`   // Issue on MP API - sometimes this is string, other is int :(
-		Email          string `json:"email,omitempty"`
-		Identification struct {
-			Type   string `json:"type,omitempty"`
-			Number string `json:"number,omitempty"`
-		} `json:"identification,omitempty"`
-		Phone struct {
-			AreaCode  string `json:"area_code,omitempty"`
-			Number    string `json:"number,omitempty"`
-			Extension string `json:"extension,omitempty"`
-		} `json:"phone,omitempty"`
-		FirstName string `json:"first_name,omitempty"`
-		LastName  string `json:"last_name,omitempty"`
+		EntityType     string         `json:"entity_type,omitempty"`
+		Type           string         `json:"type,omitempty"`
+		ID             FlexID         `json:"id,omitempty"` // Customer ID when paying with a saved card
+		Email          string         `json:"email,omitempty"`
+		Identification Identification `json:"identification,omitempty"`
+		Phone          Phone          `json:"phone,omitempty"`
+		FirstName      string         `json:"first_name,omitempty"`
+		LastName       string         `json:"last_name,omitempty"`
 	} `json:"payer,omitempty"`
 	BinaryMode bool `json:"binary_mode,omitempty"`
 	LiveMode   bool `json:"live_mode,omitempty"`
 	Order      struct {
 		Type string `json:"type,omitempty"`
-		// ID   string `json:"id,omitempty"`        // Issue on MP API - sometimes this is string, other is int :(
+		ID   FlexID `json:"id,omitempty"`
 	} `json:"order,omitempty"`
-	ExternalReference         string  `json:"external_reference,omitempty"`
-	Description               string  `json:"description,omitempty"`
-	CurrencyID                string  `json:"currency_id,omitempty"`
-	TransactionAmount         float32 `json:"transaction_amount,omitempty"`
-	TransactionAmountRefunded float32 `json:"transaction_amount_refunded,omitempty"`
-	CouponAmount              float32 `json:"coupon_amount,omitempty"`
-	CampaignID                int     `json:"campaign_id,omitempty"`
-	CouponCode                string  `json:"coupon_code,omitempty"`
+	ExternalReference         string `json:"external_reference,omitempty"`
+	Description               string `json:"description,omitempty"`
+	CurrencyID                string `json:"currency_id,omitempty"`
+	TransactionAmount         Amount `json:"transaction_amount,omitempty"`
+	TransactionAmountRefunded Amount `json:"transaction_amount_refunded,omitempty"`
+	CouponAmount              Amount `json:"coupon_amount,omitempty"`
+	CampaignID                int    `json:"campaign_id,omitempty"`
+	CouponCode                string `json:"coupon_code,omitempty"`
 	TransactionDetails        struct {
-		FinancialInstitution   string  `json:"financial_institution,omitempty"`
-		NetReceivedAmount      float32 `json:"net_received_amount,omitempty"`
-		TotalPaidAmount        float32 `json:"total_paid_amount,omitempty"`
-		InstallmentAmount      float32 `json:"installment_amount,omitempty"`
-		OverpaidAmount         float32 `json:"overpaid_amount,omitempty"`
-		PaymentMethodReference string  `json:"payment_method_reference,omitempty"`
+		FinancialInstitution   string `json:"financial_institution,omitempty"`
+		NetReceivedAmount      Amount `json:"net_received_amount,omitempty"`
+		TotalPaidAmount        Amount `json:"total_paid_amount,omitempty"`
+		InstallmentAmount      Amount `json:"installment_amount,omitempty"`
+		OverpaidAmount         Amount `json:"overpaid_amount,omitempty"`
+		PaymentMethodReference string `json:"payment_method_reference,omitempty"`
 	} `json:"transaction_details,omitempty"`
 	FeeDetails []struct {
-		Type     string  `json:"type,omitempty"`
-		FeePayer string  `json:"fee_payer,omitempty"`
-		Amount   float32 `json:"amount,omitempty"`
+		Type     string `json:"type,omitempty"`
+		FeePayer string `json:"fee_payer,omitempty"`
+		Amount   Amount `json:"amount,omitempty"`
 	} `json:"fee_details,omitempty"`
-	DifferentialPricingID int     `json:"differential_pricing_id,omitempty"`
-	ApplicationFee        float32 `json:"application_fee,omitempty"`
-	Status                string  `json:"status,omitempty"`
-	StatusDetail          string  `json:"status_detail,omitempty"`
-	Capture               bool    `json:"capture,omitempty"`
-	Captured              bool    `json:"captured,omitempty"`
-	CallForAuthorizeID    string  `json:"call_for_authorize_id,omitempty"`
-	PaymentMethodID       string  `json:"payment_method_id,omitempty"`
-	// IssuerID              string     `json:"issuer_id,omitempty"`        // Issue on MP API - sometimes this is string, other is int :(
-	PaymentTypeID string `json:"payment_type_id,omitempty"`
-	Token         string `json:"token,omitempty"`
-	Card          struct {
+	DifferentialPricingID int    `json:"differential_pricing_id,omitempty"`
+	ApplicationFee        Amount `json:"application_fee,omitempty"`
+	Status                string `json:"status,omitempty"`
+	StatusDetail          string `json:"status_detail,omitempty"`
+	Capture               bool   `json:"capture,omitempty"`
+	Captured              bool   `json:"captured,omitempty"`
+	CallForAuthorizeID    string `json:"call_for_authorize_id,omitempty"`
+	PaymentMethodID       string `json:"payment_method_id,omitempty"`
+	IssuerID              FlexID `json:"issuer_id,omitempty"`
+	PaymentTypeID         string `json:"payment_type_id,omitempty"`
+	Token                 string `json:"token,omitempty"`
+	Card                  struct {
 		ID              int    `json:"id,omitempty"`
 		LastFourDigits  string `json:"last_four_digits,omitempty"`
 		FirstSixDigits  string `json:"first_six_digits,omitempty"`
@@ -73,43 +73,24 @@ type Payment struct {
 		DateCreated     string `json:"date_created,omitempty"`
 		DateLastUpdated string `json:"date_last_updated,omitempty"`
 		Cardholder      struct {
-			Name           string `json:"name,omitempty"`
-			Identification struct {
-				Type   string `json:"type,omitempty"`
-				Number string `json:"number,omitempty"`
-			} `json:"identification,omitempty"`
+			Name           string         `json:"name,omitempty"`
+			Identification Identification `json:"identification,omitempty"`
 		} `json:"cardholder,omitempty"`
 	} `json:"card,omitempty"`
-	StatementDescriptor string `json:"statement_descriptor,omitempty"`
-	Installments        int    `json:"installments,omitempty"`
-	NotificationURL     string `json:"notification_url,omitempty"`
-	CallbackURL         string `json:"callback_url,omitempty"`
-	Refunds             []struct {
-		ID        int     `json:"id,omitempty"`
-		PaymentID int     `json:"payment_id,omitempty"`
-		Amount    float32 `json:"amount,omitempty"`
-		Source    struct {
-			ID   string `json:"id,omitempty"`
-			Name string `json:"name,omitempty"`
-			Type string `json:"type,omitempty"`
-		} `json:"source,omitempty"`
-	} `json:"refunds,omitempty"`
-	AdditionalInfo struct {
+	StatementDescriptor string   `json:"statement_descriptor,omitempty"`
+	Installments        int      `json:"installments,omitempty"`
+	NotificationURL     string   `json:"notification_url,omitempty"`
+	CallbackURL         string   `json:"callback_url,omitempty"`
+	Refunds             []Refund `json:"refunds,omitempty"`
+	AdditionalInfo      struct {
 		IPAddress string `json:"ip_address,omitempty"`
 		Items     []Item `json:"items,omitempty"`
 		Payer     struct {
-			FirstName string `json:"first_name,omitempty"`
-			LastName  string `json:"last_name,omitempty"`
-			Phone     struct {
-				AreaCode string `json:"area_code,omitempty"`
-				Number   string `json:"number,omitempty"`
-			} `json:"phone,omitempty"`
-			Address struct {
-				ZipCode      string `json:"zip_code,omitempty"`
-				StreetName   string `json:"street_name,omitempty"`
-				StreetNumber int    `json:"street_number,omitempty"`
-			} `json:"address,omitempty"`
-			RegistrationDate string `json:"registration_date,omitempty"`
+			FirstName        string  `json:"first_name,omitempty"`
+			LastName         string  `json:"last_name,omitempty"`
+			Phone            Phone   `json:"phone,omitempty"`
+			Address          Address `json:"address,omitempty"`
+			RegistrationDate string  `json:"registration_date,omitempty"`
 		} `json:"payer,omitempty"`
 		Shipments struct {
 			ReceiverAddress struct {
@@ -138,3 +119,126 @@ type PaymentSearch struct {
 	} `json:"paging,omitempty"`
 	Results []Payment `json:"results,omitempty"`
 }
+
+// paymentCapture is the update sent to capture an authorized payment
+type paymentCapture struct {
+	Capture           bool   `json:"capture"`
+	TransactionAmount Amount `json:"transaction_amount,omitempty"`
+}
+
+// paymentStatusUpdate is the update sent to change the status of a payment
+type paymentStatusUpdate struct {
+	Status string `json:"status"`
+}
+
+// PayerTypeCustomer is the payer type of payments made with the saved card of a customer
+const PayerTypeCustomer string = "customer"
+
+// UseSavedCard sets the payment to be charged to a saved card of a customer.
+// cardToken is a card token created for the saved card ID and its security code (CVV).
+func (p *Payment) UseSavedCard(customerID string, cardToken string) {
+	p.Payer.Type = PayerTypeCustomer
+	p.Payer.ID = FlexID(customerID)
+	p.Token = cardToken
+}
+
+// Payment status values
+const (
+	PaymentStatusPending     string = "pending"
+	PaymentStatusApproved    string = "approved"
+	PaymentStatusAuthorized  string = "authorized"
+	PaymentStatusInProcess   string = "in_process"
+	PaymentStatusInMediation string = "in_mediation"
+	PaymentStatusRejected    string = "rejected"
+	PaymentStatusCancelled   string = "cancelled"
+	PaymentStatusRefunded    string = "refunded"
+	PaymentStatusChargedBack string = "charged_back"
+)
+
+// Payment date fields usable as search range
+const (
+	RangeDateCreated      string = "date_created"
+	RangeDateApproved     string = "date_approved"
+	RangeDateLastUpdated  string = "date_last_updated"
+	RangeMoneyReleaseDate string = "money_release_date"
+)
+
+// PaymentSearchQuery are the criteria to search payments
+type PaymentSearchQuery struct {
+	Status            string
+	ExternalReference string
+	PayerEmail        string
+	PaymentMethodID   string
+	PaymentTypeID     string
+	Range             string    // Date field filtered by BeginDate and EndDate, defaults to RangeDateCreated
+	BeginDate         time.Time // Zero value means no lower bound
+	EndDate           time.Time // Zero value means no upper bound
+	Sort              string    // Field to sort by, e.g. "date_created"
+	Criteria          string    // Sort order: "asc" or "desc"
+	Limit             int
+	Offset            int
+}
+
+// Values validates the query and encodes it in the format expected by the MP API
+func (q *PaymentSearchQuery) Values() (*url.Values, error) {
+	values := &url.Values{}
+	if q == nil {
+		return values, nil
+	}
+	set := func(key string, value string) {
+		if value != "" {
+			values.Set(key, value)
+		}
+	}
+	set("status", q.Status)
+	set("external_reference", q.ExternalReference)
+	set("payer.email", q.PayerEmail)
+	set("payment_method_id", q.PaymentMethodID)
+	set("payment_type_id", q.PaymentTypeID)
+	// Date range
+	if q.BeginDate.IsZero() && q.EndDate.IsZero() {
+		if q.Range != "" {
+			return nil, fmt.Errorf("mercadopago: search range %s requires BeginDate or EndDate", q.Range)
+		}
+	} else {
+		switch q.Range {
+		case "":
+			values.Set("range", RangeDateCreated)
+		case RangeDateCreated, RangeDateApproved, RangeDateLastUpdated, RangeMoneyReleaseDate:
+			values.Set("range", q.Range)
+		default:
+			return nil, fmt.Errorf("mercadopago: invalid search range %s", q.Range)
+		}
+		if !q.BeginDate.IsZero() && !q.EndDate.IsZero() && q.EndDate.Before(q.BeginDate) {
+			return nil, fmt.Errorf("mercadopago: search EndDate %v is before BeginDate %v", q.EndDate, q.BeginDate)
+		}
+		if !q.BeginDate.IsZero() {
+			values.Set("begin_date", q.BeginDate.Format(searchDateFormat))
+		}
+		if !q.EndDate.IsZero() {
+			values.Set("end_date", q.EndDate.Format(searchDateFormat))
+		}
+	}
+	// Sorting
+	switch q.Criteria {
+	case "", "asc", "desc":
+	default:
+		return nil, fmt.Errorf("mercadopago: invalid search criteria %s, expected asc or desc", q.Criteria)
+	}
+	if q.Criteria != "" && q.Sort == "" {
+		return nil, fmt.Errorf("mercadopago: search criteria requires a Sort field")
+	}
+	set("sort", q.Sort)
+	set("criteria", q.Criteria)
+	// Paging
+	if q.Limit < 0 || q.Offset < 0 {
+		return nil, fmt.Errorf("mercadopago: search Limit and Offset can not be negative")
+	}
+	if q.Limit > 0 {
+		values.Set("limit", strconv.Itoa(q.Limit))
+	}
+	if q.Offset > 0 {
+		values.Set("offset", strconv.Itoa(q.Offset))
+	}
+	return values, nil
+}
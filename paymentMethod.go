@@ -0,0 +1,180 @@
+package mercadopago
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// paymentMethodsCache keeps the payment methods catalog for a while
+type paymentMethodsCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	methods []PaymentMethod
+	expires time.Time
+}
+
+// WithPaymentMethodsCache caches the payment methods catalog for ttl, so it is not fetched on every call
+func WithPaymentMethodsCache(ttl time.Duration) Option {
+	return func(mp *MP) {
+		mp.paymentMethods.ttl = ttl
+	}
+}
+
+// ListPaymentMethods Get the payment methods available for the account
+//	@return json
+func (mp *MP) ListPaymentMethods() ([]PaymentMethod, error) {
+	return mp.ListPaymentMethodsWithContext(context.Background())
+}
+
+// ListPaymentMethodsWithContext Get the payment methods available for the account
+//	@param ctx
+//	@return json
+func (mp *MP) ListPaymentMethodsWithContext(ctx context.Context) ([]PaymentMethod, error) {
+	cache := &mp.paymentMethods
+	if cache.ttl > 0 {
+		cache.mu.Lock()
+		defer cache.mu.Unlock()
+		if cache.methods != nil && time.Now().Before(cache.expires) {
+			return append([]PaymentMethod(nil), cache.methods...), nil
+		}
+	}
+	res := []PaymentMethod{}
+	uri := fmt.Sprintf("/v1/payment_methods")
+	// Call GET method
+	r, err := mp.jget(ctx, uri, nil, customAuth)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	// Check response status
+	if r.StatusCode != 200 {
+		return nil, newResponseError(r)
+	}
+	// Read response Body and unmarshall content
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, &res); err != nil {
+		return nil, err
+	}
+	if cache.ttl > 0 {
+		cache.methods = res
+		cache.expires = time.Now().Add(cache.ttl)
+		return append([]PaymentMethod(nil), res...), nil
+	}
+	return res, nil
+}
+
+// GetPaymentMethod Get a payment method by ID from the catalog
+//	@param id
+//	@return json
+func (mp *MP) GetPaymentMethod(id string) (*PaymentMethod, error) {
+	return mp.GetPaymentMethodWithContext(context.Background(), id)
+}
+
+// GetPaymentMethodWithContext Get a payment method by ID from the catalog
+//	@param ctx
+//	@param id
+//	@return json
+func (mp *MP) GetPaymentMethodWithContext(ctx context.Context, id string) (*PaymentMethod, error) {
+	methods, err := mp.ListPaymentMethodsWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range methods {
+		if methods[i].ID == id {
+			return &methods[i], nil
+		}
+	}
+	return nil, newMercadoPagoError(fmt.Sprintf("payment method %s not found", id), 404)
+}
+
+// GetInstallments Get the installment options for a card and amount
+//	@param bin: first digits of the card, may be empty if paymentMethodID is set
+//	@param amount
+//	@param paymentMethodID: may be empty if bin is set
+//	@return json
+func (mp *MP) GetInstallments(bin string, amount Amount, paymentMethodID string) ([]Installments, error) {
+	return mp.GetInstallmentsWithContext(context.Background(), bin, amount, paymentMethodID)
+}
+
+// GetInstallmentsWithContext Get the installment options for a card and amount
+//	@param ctx
+//	@param bin: first digits of the card, may be empty if paymentMethodID is set
+//	@param amount
+//	@param paymentMethodID: may be empty if bin is set
+//	@return json
+func (mp *MP) GetInstallmentsWithContext(ctx context.Context, bin string, amount Amount, paymentMethodID string) ([]Installments, error) {
+	res := []Installments{}
+	uri := fmt.Sprintf("/v1/payment_methods/installments")
+	data := &url.Values{}
+	if bin != "" {
+		data.Add("bin", bin)
+	}
+	if paymentMethodID != "" {
+		data.Add("payment_method_id", paymentMethodID)
+	}
+	data.Add("amount", amount.String())
+	// Call GET method
+	r, err := mp.get(ctx, uri, data, customAuth)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	// Check response status
+	if r.StatusCode != 200 {
+		return nil, newResponseError(r)
+	}
+	// Read response Body and unmarshall content
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListCardIssuers Get the card issuers of a payment method
+//	@param paymentMethodID
+//	@return json
+func (mp *MP) ListCardIssuers(paymentMethodID string) ([]CardIssuer, error) {
+	return mp.ListCardIssuersWithContext(context.Background(), paymentMethodID)
+}
+
+// ListCardIssuersWithContext Get the card issuers of a payment method
+//	@param ctx
+//	@param paymentMethodID
+//	@return json
+func (mp *MP) ListCardIssuersWithContext(ctx context.Context, paymentMethodID string) ([]CardIssuer, error) {
+	res := []CardIssuer{}
+	uri := fmt.Sprintf("/v1/payment_methods/card_issuers")
+	data := &url.Values{}
+	data.Add("payment_method_id", paymentMethodID)
+	// Call GET method
+	r, err := mp.get(ctx, uri, data, customAuth)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	// Check response status
+	if r.StatusCode != 200 {
+		return nil, newResponseError(r)
+	}
+	// Read response Body and unmarshall content
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,72 @@
+package mercadopago
+
+// PaymentMethod is the data struct for payment methods MP API
+type PaymentMethod struct {
+	ID                    string                  `json:"id,omitempty"`
+	Name                  string                  `json:"name,omitempty"`
+	PaymentTypeID         string                  `json:"payment_type_id,omitempty"`
+	Status                string                  `json:"status,omitempty"`
+	SecureThumbnail       string                  `json:"secure_thumbnail,omitempty"`
+	Thumbnail             string                  `json:"thumbnail,omitempty"`
+	DeferredCapture       string                  `json:"deferred_capture,omitempty"`
+	Settings              []PaymentMethodSettings `json:"settings,omitempty"`
+	AdditionalInfoNeeded  []string                `json:"additional_info_needed,omitempty"`
+	MinAllowedAmount      Amount                  `json:"min_allowed_amount,omitempty"`
+	MaxAllowedAmount      Amount                  `json:"max_allowed_amount,omitempty"`
+	AccreditationTime     int                     `json:"accreditation_time,omitempty"` // Minutes
+	ProcessingModes       []string                `json:"processing_modes,omitempty"`
+	FinancialInstitutions []struct {
+		ID          string `json:"id,omitempty"`
+		Description string `json:"description,omitempty"`
+	} `json:"financial_institutions,omitempty"`
+}
+
+// PaymentMethodSettings are the card validation rules of a payment method
+type PaymentMethodSettings struct {
+	CardNumber struct {
+		Validation string `json:"validation,omitempty"`
+		Length     int    `json:"length,omitempty"`
+	} `json:"card_number,omitempty"`
+	Bin struct {
+		Pattern             string `json:"pattern,omitempty"`
+		InstallmentsPattern string `json:"installments_pattern,omitempty"`
+		ExclusionPattern    string `json:"exclusion_pattern,omitempty"`
+	} `json:"bin,omitempty"`
+	SecurityCode struct {
+		Length       int    `json:"length,omitempty"`
+		CardLocation string `json:"card_location,omitempty"`
+		Mode         string `json:"mode,omitempty"`
+	} `json:"security_code,omitempty"`
+}
+
+// Installments is the data struct for the installment options of a payment method and issuer
+type Installments struct {
+	PaymentMethodID string      `json:"payment_method_id,omitempty"`
+	PaymentTypeID   string      `json:"payment_type_id,omitempty"`
+	ProcessingMode  string      `json:"processing_mode,omitempty"`
+	Issuer          CardIssuer  `json:"issuer,omitempty"`
+	PayerCosts      []PayerCost `json:"payer_costs,omitempty"`
+}
+
+// PayerCost is an installment option, with its rate and total amount to pay
+type PayerCost struct {
+	Installments       int      `json:"installments,omitempty"`
+	InstallmentRate    float64  `json:"installment_rate,omitempty"`
+	DiscountRate       float64  `json:"discount_rate,omitempty"`
+	Labels             []string `json:"labels,omitempty"`
+	MinAllowedAmount   Amount   `json:"min_allowed_amount,omitempty"`
+	MaxAllowedAmount   Amount   `json:"max_allowed_amount,omitempty"`
+	RecommendedMessage string   `json:"recommended_message,omitempty"`
+	InstallmentAmount  Amount   `json:"installment_amount,omitempty"`
+	TotalAmount        Amount   `json:"total_amount,omitempty"`
+}
+
+// CardIssuer is the data struct for card issuers MP API
+type CardIssuer struct {
+	ID              FlexID `json:"id,omitempty"`
+	Name            string `json:"name,omitempty"`
+	SecureThumbnail string `json:"secure_thumbnail,omitempty"`
+	Thumbnail       string `json:"thumbnail,omitempty"`
+	ProcessingMode  string `json:"processing_mode,omitempty"`
+	Status          string `json:"status,omitempty"`
+}
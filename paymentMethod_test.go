@@ -0,0 +1,90 @@
+package mercadopago_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gpascual2/mp-sdk-go"
+)
+
+// TestListPaymentMethods - The payment methods catalog should be fetched once while cached
+func TestListPaymentMethods(t *testing.T) {
+	fmt.Println("mp_test : ListPaymentMethods")
+	calls := 0
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `[
+			{"id":"visa","name":"Visa","payment_type_id":"credit_card","status":"active","min_allowed_amount":0.5,"max_allowed_amount":250000,"accreditation_time":2880,
+			 "settings":[{"card_number":{"validation":"standard","length":16},"bin":{"pattern":"^4"},"security_code":{"length":3,"card_location":"back","mode":"mandatory"}}]},
+			{"id":"rapipago","name":"Rapipago","payment_type_id":"ticket","status":"active","accreditation_time":0}
+		]`)
+	})
+
+	local := newFakeMP(server.URL, mercadopago.WithPaymentMethodsCache(time.Hour))
+	methods, err := local.ListPaymentMethods()
+	if err != nil {
+		t.Fatalf("Error listing the payment methods: %v", err)
+	}
+	if len(methods) != 2 || methods[0].Settings[0].SecurityCode.Length != 3 || methods[0].AccreditationTime != 2880 {
+		t.Errorf("Unexpected payment methods: %+v", methods)
+	}
+	pm, err := local.GetPaymentMethod("rapipago")
+	if err != nil {
+		t.Fatalf("Error getting the payment method: %v", err)
+	}
+	if pm.PaymentTypeID != "ticket" {
+		t.Errorf("Expected rapipago to be a ticket payment method and got %s", pm.PaymentTypeID)
+	}
+	if calls != 1 {
+		t.Errorf("Expected the catalog to be fetched once and got %d calls", calls)
+	}
+}
+
+// TestGetInstallments - The installment options for a card BIN and amount should be obtained from MercadoPago API
+func TestGetInstallments(t *testing.T) {
+	fmt.Println("mp_test : GetInstallments")
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/v1/payment_methods/installments" || q.Get("bin") != "450995" || q.Get("amount") != "10.2" {
+			t.Errorf("Unexpected request %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `[{"payment_method_id":"visa","payment_type_id":"credit_card","issuer":{"id":"310","name":"Visa"},
+			"payer_costs":[{"installments":1,"installment_rate":0,"labels":["CFT_0,00%|TEA_0,00%"],"installment_amount":10.2,"total_amount":10.2},
+			{"installments":3,"installment_rate":12.5,"labels":["CFT_150,00%|TEA_120,00%"],"installment_amount":3.83,"total_amount":11.48}]}]`)
+	})
+
+	local := newFakeMP(server.URL)
+	installments, err := local.GetInstallments("450995", mercadopago.MustParseAmount("10.2"), "")
+	if err != nil {
+		t.Fatalf("Error getting the installments: %v", err)
+	}
+	if len(installments) != 1 || installments[0].Issuer.ID != "310" {
+		t.Fatalf("Unexpected installments: %+v", installments)
+	}
+	costs := installments[0].PayerCosts
+	if len(costs) != 2 || costs[1].Installments != 3 || costs[1].InstallmentRate != 12.5 || costs[1].Labels[0] == "" {
+		t.Errorf("Unexpected payer costs: %+v", costs)
+	}
+}
+
+// TestListCardIssuers - The card issuers of a payment method should be obtained from MercadoPago API
+func TestListCardIssuers(t *testing.T) {
+	fmt.Println("mp_test : ListCardIssuers")
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("payment_method_id") != "visa" {
+			t.Errorf("Unexpected request %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `[{"id":310,"name":"Visa"},{"id":"1","name":"Banco Galicia"}]`)
+	})
+
+	local := newFakeMP(server.URL)
+	issuers, err := local.ListCardIssuers("visa")
+	if err != nil {
+		t.Fatalf("Error listing the card issuers: %v", err)
+	}
+	if len(issuers) != 2 || issuers[0].ID != "310" || issuers[1].Name != "Banco Galicia" {
+		t.Errorf("Unexpected card issuers: %+v", issuers)
+	}
+}
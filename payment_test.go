@@ -1,9 +1,16 @@
 package mercadopago_test
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"testing"
+	"time"
+
+	"github.com/gpascual2/mp-sdk-go"
 )
 
 // TestGetPayment - A payment should be obtained from MercadoPago API
@@ -44,3 +51,150 @@ func TestPaymentsSearch(t *testing.T) {
 	}
 	fmt.Println("Payments: ", pmtSearch)
 }
+
+// TestSearchPayments - A list of payments matching a typed query should be obtained from MercadoPago API
+func TestSearchPayments(t *testing.T) {
+	fmt.Println("mp_test : SearchPayments")
+
+	query := &mercadopago.PaymentSearchQuery{
+		Status:    mercadopago.PaymentStatusApproved,
+		BeginDate: time.Now().AddDate(0, -1, 0),
+		EndDate:   time.Now(),
+	}
+	pmtSearch, err := mp.SearchPayments(query)
+	if err != nil {
+		t.Fatalf("Error getting the payment: %v", err)
+	}
+	fmt.Println("Payments: ", pmtSearch)
+}
+
+// TestCapturePayment - An authorized payment should be captured for the given amount
+func TestCapturePayment(t *testing.T) {
+	fmt.Println("mp_test : CapturePayment")
+	var sent map[string]interface{}
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/v1/payments/8262805" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		json.NewDecoder(r.Body).Decode(&sent)
+		fmt.Fprint(w, `{"id":8262805,"status":"approved","captured":true,"transaction_amount":7.5}`)
+	})
+
+	local := newFakeMP(server.URL)
+	pmt, err := local.CapturePayment("8262805", mercadopago.MustParseAmount("7.5"))
+	if err != nil {
+		t.Fatalf("Error capturing the payment: %v", err)
+	}
+	if sent["capture"] != true || sent["transaction_amount"] != 7.5 {
+		t.Errorf("Expected capture of 7.5 to be sent and got %v", sent)
+	}
+	if !pmt.Captured {
+		t.Errorf("Expected payment to be captured")
+	}
+}
+
+// TestCancelPayment - A pending payment should be cancelled
+func TestCancelPayment(t *testing.T) {
+	fmt.Println("mp_test : CancelPayment")
+	var sent map[string]interface{}
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&sent)
+		fmt.Fprint(w, `{"id":8262805,"status":"cancelled"}`)
+	})
+
+	local := newFakeMP(server.URL)
+	pmt, err := local.CancelPayment("8262805")
+	if err != nil {
+		t.Fatalf("Error cancelling the payment: %v", err)
+	}
+	if sent["status"] != "cancelled" || pmt.Status != "cancelled" {
+		t.Errorf("Expected payment to be cancelled, sent %v and got %s", sent, pmt.Status)
+	}
+}
+
+// TestPaymentSearchQuery - A typed payment search query should be encoded in the MP API format
+func TestPaymentSearchQuery(t *testing.T) {
+	fmt.Println("mp_test : PaymentSearchQuery")
+	begin := time.Date(2017, 3, 1, 0, 0, 0, 0, time.UTC)
+	query := &mercadopago.PaymentSearchQuery{
+		Status:     mercadopago.PaymentStatusApproved,
+		PayerEmail: "jonsnow@winterfell.north",
+		BeginDate:  begin,
+		EndDate:    begin.AddDate(0, 1, 0),
+		Sort:       "date_created",
+		Criteria:   "desc",
+		Limit:      50,
+	}
+	values, err := query.Values()
+	if err != nil {
+		t.Fatalf("Error encoding the payment search query: %v", err)
+	}
+	expected := "begin_date=2017-03-01T00%3A00%3A00.000%2B00%3A00&criteria=desc&end_date=2017-04-01T00%3A00%3A00.000%2B00%3A00&limit=50&payer.email=jonsnow%40winterfell.north&range=date_created&sort=date_created&status=approved"
+	if values.Encode() != expected {
+		t.Errorf("Expected query to be encoded as %s and got %s", expected, values.Encode())
+	}
+
+	query.EndDate = begin.AddDate(0, -1, 0)
+	if _, err := query.Values(); err == nil {
+		t.Errorf("Expected an error for an EndDate before BeginDate")
+	}
+	query = &mercadopago.PaymentSearchQuery{Criteria: "asc"}
+	if _, err := query.Values(); err == nil {
+		t.Errorf("Expected an error for a Criteria without Sort")
+	}
+}
+
+// TestIterPayments - All the payments matching a search should be walked across pages
+func TestIterPayments(t *testing.T) {
+	fmt.Println("mp_test : IterPayments")
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("offset") {
+		case "":
+			fmt.Fprint(w, `{"paging":{"total":5,"limit":2,"offset":0},"results":[{"id":1},{"id":2}]}`)
+		case "2":
+			fmt.Fprint(w, `{"paging":{"total":5,"limit":2,"offset":2},"results":[{"id":3},{"id":4}]}`)
+		default:
+			fmt.Fprint(w, `{"paging":{"total":5,"limit":2,"offset":4},"results":[{"id":5}]}`)
+		}
+	})
+
+	local := newFakeMP(server.URL)
+	for _, prefetch := range []bool{false, true} {
+		it := local.IterPayments(context.Background(), &mercadopago.PaymentSearchQuery{Limit: 2})
+		if prefetch {
+			it = it.WithPrefetch()
+		}
+		var ids []int
+		for it.Next() {
+			ids = append(ids, it.Payment().ID)
+		}
+		if err := it.Err(); err != nil {
+			t.Fatalf("Error iterating the payments: %v", err)
+		}
+		if len(ids) != 5 || ids[4] != 5 {
+			t.Errorf("Expected 5 payments (prefetch %v) and got %v", prefetch, ids)
+		}
+	}
+}
+
+// TestIterPaymentsCancel - The iteration should stop when the context is cancelled
+func TestIterPaymentsCancel(t *testing.T) {
+	fmt.Println("mp_test : IterPaymentsCancel")
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"paging":{"total":100,"limit":1},"results":[{"id":1}]}`)
+	})
+
+	local := newFakeMP(server.URL)
+	ctx, cancel := context.WithCancel(context.Background())
+	it := local.IterPayments(ctx, &mercadopago.PaymentSearchQuery{Limit: 1}).WithPrefetch()
+	if !it.Next() {
+		t.Fatalf("Expected a first payment, got error %v", it.Err())
+	}
+	cancel()
+	if it.Next() {
+		t.Errorf("Expected the iteration to stop after cancelling the context")
+	}
+	if !errors.Is(it.Err(), context.Canceled) {
+		t.Errorf("Expected error to be context.Canceled and got %v", it.Err())
+	}
+}
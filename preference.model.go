@@ -1,21 +1,21 @@
 package mercadopago
 
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
 // Preference is the data struct for payment checkouts
 type Preference struct {
 	Items []Item `json:"items,omitempty"`
 	Payer struct {
-		Name    string `json:"name,omitempty"`
-		Surname string `json:"surname,omitempty"`
-		Email   string `json:"email,omitempty"`
-		Phone   struct {
-			AreaCode string `json:"area_code,omitempty"`
-			Number   string `json:"number,omitempty"`
-		} `json:"phone,omitempty"`
-		Identification struct {
-			Type   string `json:"type,omitempty"`
-			Number string `json:"number,omitempty"`
-		} `json:"identification,omitempty"`
-		Address struct {
+		Name           string         `json:"name,omitempty"`
+		Surname        string         `json:"surname,omitempty"`
+		Email          string         `json:"email,omitempty"`
+		Phone          Phone          `json:"phone,omitempty"`
+		Identification Identification `json:"identification,omitempty"`
+		Address        struct {
 			ZipCode string `json:"zip_code,omitempty"`
 			Street  string `json:"street,omitempty"`
 			Number  int    `json:"number,omitempty"`
@@ -30,13 +30,13 @@ type Preference struct {
 		DefaultInstallments    int    `json:"default_installments,omitempty"`
 	} `json:"payment_methods,omitempty"`
 	Shipments struct {
-		Mode                  string  `json:"mode,omitempty"`
-		LocalPickup           bool    `json:"local_pickup,omitempty"`
-		Dimensions            string  `json:"dimensions,omitempty"`
-		DefaultShippingMethod int     `json:"default_shipping_method,omitempty"`
-		FreeMethods           []ID    `json:"free_methods,omitempty"`
-		Cost                  float32 `json:"cost,omitempty"`
-		FreeShipping          bool    `json:"free_shipping,omitempty"`
+		Mode                  string `json:"mode,omitempty"`
+		LocalPickup           bool   `json:"local_pickup,omitempty"`
+		Dimensions            string `json:"dimensions,omitempty"`
+		DefaultShippingMethod int    `json:"default_shipping_method,omitempty"`
+		FreeMethods           []ID   `json:"free_methods,omitempty"`
+		Cost                  Amount `json:"cost,omitempty"`
+		FreeShipping          bool   `json:"free_shipping,omitempty"`
 		ReceiverAddress       struct {
 			ZipCode      string `json:"zip_code,omitempty"`
 			StreetName   string `json:"street_name,omitempty"`
@@ -68,17 +68,111 @@ type Preference struct {
 
 // Item information
 type Item struct {
-	ID          string  `json:"id,omitempty"`
-	Title       string  `json:"title,omitempty"`
-	Description string  `json:"description,omitempty"`
-	PictureURL  string  `json:"picture_url,omitempty"`
-	CategoryID  string  `json:"category_id,omitempty"`
-	Quantity    int     `json:"quantity,omitempty"`
-	CurrencyID  string  `json:"currency_id,omitempty"`
-	UnitPrice   float32 `json:"unit_price,omitempty"`
+	ID          string `json:"id,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	PictureURL  string `json:"picture_url,omitempty"`
+	CategoryID  string `json:"category_id,omitempty"`
+	Quantity    int    `json:"quantity,omitempty"`
+	CurrencyID  string `json:"currency_id,omitempty"`
+	UnitPrice   Amount `json:"unit_price,omitempty"`
 }
 
 // ID generic struct
 type ID struct {
 	ID string `json:"id,omitempty"`
 }
+
+// Identification is the personal document of a payer or customer
+type Identification struct {
+	Type   string `json:"type,omitempty"`
+	Number string `json:"number,omitempty"`
+}
+
+// Phone information
+type Phone struct {
+	AreaCode  string `json:"area_code,omitempty"`
+	Number    string `json:"number,omitempty"`
+	Extension string `json:"extension,omitempty"`
+}
+
+// Address information
+type Address struct {
+	ID           string `json:"id,omitempty"`
+	ZipCode      string `json:"zip_code,omitempty"`
+	StreetName   string `json:"street_name,omitempty"`
+	StreetNumber int    `json:"street_number,omitempty"`
+}
+
+// PreferenceSearchFilters are the criteria to search checkout preferences
+type PreferenceSearchFilters struct {
+	ExternalReference string
+	CollectorID       int
+	SponsorID         int
+	DateCreatedFrom   time.Time // Zero value means no lower bound
+	DateCreatedTo     time.Time // Zero value means no upper bound
+	Limit             int
+	Offset            int
+}
+
+// PreferenceSearch is the data struct for checkout preference search results
+type PreferenceSearch struct {
+	Elements   []PreferenceSummary `json:"elements,omitempty"`
+	NextOffset int                 `json:"next_offset,omitempty"`
+	Total      int                 `json:"total,omitempty"`
+}
+
+// PreferenceSummary is the reduced view of a checkout preference returned by searches
+type PreferenceSummary struct {
+	ID                 string   `json:"id,omitempty"`
+	ClientID           string   `json:"client_id,omitempty"`
+	CollectorID        int      `json:"collector_id,omitempty"`
+	SponsorID          int      `json:"sponsor_id,omitempty"`
+	DateCreated        string   `json:"date_created,omitempty"`
+	LastUpdated        string   `json:"last_updated,omitempty"`
+	Expires            bool     `json:"expires,omitempty"`
+	ExpirationDateFrom string   `json:"expiration_date_from,omitempty"`
+	ExpirationDateTo   string   `json:"expiration_date_to,omitempty"`
+	ExternalReference  string   `json:"external_reference,omitempty"`
+	Items              []string `json:"items,omitempty"`
+	LiveMode           bool     `json:"live_mode,omitempty"`
+	Marketplace        string   `json:"marketplace,omitempty"`
+	OperationType      string   `json:"operation_type,omitempty"`
+	PayerEmail         string   `json:"payer_email,omitempty"`
+	SiteID             string   `json:"site_id,omitempty"`
+}
+
+// Date format used by the MP API search filters
+const searchDateFormat string = "2006-01-02T15:04:05.000-07:00"
+
+func (f *PreferenceSearchFilters) values() *url.Values {
+	values := &url.Values{}
+	if f == nil {
+		return values
+	}
+	if f.ExternalReference != "" {
+		values.Set("external_reference", f.ExternalReference)
+	}
+	if f.CollectorID != 0 {
+		values.Set("collector_id", strconv.Itoa(f.CollectorID))
+	}
+	if f.SponsorID != 0 {
+		values.Set("sponsor_id", strconv.Itoa(f.SponsorID))
+	}
+	if !f.DateCreatedFrom.IsZero() || !f.DateCreatedTo.IsZero() {
+		values.Set("range", "date_created")
+		if !f.DateCreatedFrom.IsZero() {
+			values.Set("begin_date", f.DateCreatedFrom.Format(searchDateFormat))
+		}
+		if !f.DateCreatedTo.IsZero() {
+			values.Set("end_date", f.DateCreatedTo.Format(searchDateFormat))
+		}
+	}
+	if f.Limit > 0 {
+		values.Set("limit", strconv.Itoa(f.Limit))
+	}
+	if f.Offset > 0 {
+		values.Set("offset", strconv.Itoa(f.Offset))
+	}
+	return values
+}
@@ -0,0 +1,147 @@
+package mercadopago
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+// RefundPayment Refunds the total amount of a payment
+//	@param id
+//	@param opts
+//	@return json
+func (mp *MP) RefundPayment(id string, opts ...RequestOption) (*Refund, error) {
+	return mp.RefundPaymentWithContext(context.Background(), id, opts...)
+}
+
+// RefundPaymentWithContext Refunds the total amount of a payment
+//	@param ctx
+//	@param id
+//	@param opts
+//	@return json
+func (mp *MP) RefundPaymentWithContext(ctx context.Context, id string, opts ...RequestOption) (*Refund, error) {
+	return mp.createRefund(ctx, id, nil, opts)
+}
+
+// PartialRefund Refunds part of the amount of a payment
+//	@param id
+//	@param amount
+//	@param opts
+//	@return json
+func (mp *MP) PartialRefund(id string, amount Amount, opts ...RequestOption) (*Refund, error) {
+	return mp.PartialRefundWithContext(context.Background(), id, amount, opts...)
+}
+
+// PartialRefundWithContext Refunds part of the amount of a payment
+//	@param ctx
+//	@param id
+//	@param amount
+//	@param opts
+//	@return json
+func (mp *MP) PartialRefundWithContext(ctx context.Context, id string, amount Amount, opts ...RequestOption) (*Refund, error) {
+	if amount <= 0 {
+		return nil, fmt.Errorf("mercadopago: refund amount must be positive, got %v", amount)
+	}
+	return mp.createRefund(ctx, id, &refundRequest{Amount: amount}, opts)
+}
+
+func (mp *MP) createRefund(ctx context.Context, id string, refund *refundRequest, opts []RequestOption) (*Refund, error) {
+	res := &Refund{}
+	uri := fmt.Sprintf("/v1/payments/%v/refunds", id)
+	// Call POST method
+	var data interface{}
+	if refund != nil {
+		data = refund
+	}
+	r, err := mp.post(ctx, uri, data, customAuth, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	// Check response status
+	if r.StatusCode != 200 && r.StatusCode != 201 {
+		return nil, newResponseError(r)
+	}
+	// Read response Body and unmarshall content
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetRefund Get a refund of a payment
+//	@param paymentID
+//	@param refundID
+//	@return json
+func (mp *MP) GetRefund(paymentID string, refundID string) (*Refund, error) {
+	return mp.GetRefundWithContext(context.Background(), paymentID, refundID)
+}
+
+// GetRefundWithContext Get a refund of a payment
+//	@param ctx
+//	@param paymentID
+//	@param refundID
+//	@return json
+func (mp *MP) GetRefundWithContext(ctx context.Context, paymentID string, refundID string) (*Refund, error) {
+	res := &Refund{}
+	uri := fmt.Sprintf("/v1/payments/%v/refunds/%v", paymentID, refundID)
+	// Call GET method
+	r, err := mp.jget(ctx, uri, nil, customAuth)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	// Check response status
+	if r.StatusCode != 200 {
+		return nil, newResponseError(r)
+	}
+	// Read response Body and unmarshall content
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListRefunds Get all the refunds of a payment
+//	@param paymentID
+//	@return json
+func (mp *MP) ListRefunds(paymentID string) ([]Refund, error) {
+	return mp.ListRefundsWithContext(context.Background(), paymentID)
+}
+
+// ListRefundsWithContext Get all the refunds of a payment
+//	@param ctx
+//	@param paymentID
+//	@return json
+func (mp *MP) ListRefundsWithContext(ctx context.Context, paymentID string) ([]Refund, error) {
+	res := []Refund{}
+	uri := fmt.Sprintf("/v1/payments/%v/refunds", paymentID)
+	// Call GET method
+	r, err := mp.jget(ctx, uri, nil, customAuth)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	// Check response status
+	if r.StatusCode != 200 {
+		return nil, newResponseError(r)
+	}
+	// Read response Body and unmarshall content
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(body, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
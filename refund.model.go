@@ -0,0 +1,23 @@
+package mercadopago
+
+// Refund is the data struct for payment refunds MP API
+type Refund struct {
+	ID                   int    `json:"id,omitempty"`
+	PaymentID            int    `json:"payment_id,omitempty"`
+	Amount               Amount `json:"amount,omitempty"`
+	AdjustmentAmount     Amount `json:"adjustment_amount,omitempty"`
+	Status               string `json:"status,omitempty"`
+	RefundMode           string `json:"refund_mode,omitempty"`
+	DateCreated          string `json:"date_created,omitempty"`
+	UniqueSequenceNumber string `json:"unique_sequence_number,omitempty"`
+	Source               struct {
+		ID   string `json:"id,omitempty"`
+		Name string `json:"name,omitempty"`
+		Type string `json:"type,omitempty"`
+	} `json:"source,omitempty"`
+}
+
+// refundRequest is the body sent to request a partial refund
+type refundRequest struct {
+	Amount Amount `json:"amount,omitempty"`
+}
@@ -0,0 +1,53 @@
+package mercadopago_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gpascual2/mp-sdk-go"
+)
+
+// TestPartialRefund - A partial refund should be requested for the given amount
+func TestPartialRefund(t *testing.T) {
+	fmt.Println("mp_test : PartialRefund")
+	var sent map[string]interface{}
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/v1/payments/8262805/refunds" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		json.NewDecoder(r.Body).Decode(&sent)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":1009042015,"payment_id":8262805,"amount":5.5,"status":"approved","source":{"id":"130379930","name":"Jon Snow","type":"collector"}}`)
+	})
+
+	local := newFakeMP(server.URL)
+	refund, err := local.PartialRefund("8262805", mercadopago.MustParseAmount("5.5"))
+	if err != nil {
+		t.Fatalf("Error refunding the payment: %v", err)
+	}
+	if sent["amount"] != 5.5 {
+		t.Errorf("Expected refund amount 5.5 to be sent and got %v", sent)
+	}
+	if refund.Status != "approved" || refund.Source.Type != "collector" {
+		t.Errorf("Unexpected refund received: %+v", refund)
+	}
+}
+
+// TestListRefunds - The refunds of a payment should be obtained from MercadoPago API
+func TestListRefunds(t *testing.T) {
+	fmt.Println("mp_test : ListRefunds")
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":1,"payment_id":8262805,"amount":5.5},{"id":2,"payment_id":8262805,"amount":4.7}]`)
+	})
+
+	local := newFakeMP(server.URL)
+	refunds, err := local.ListRefunds("8262805")
+	if err != nil {
+		t.Fatalf("Error listing the refunds: %v", err)
+	}
+	if len(refunds) != 2 || refunds[1].ID != 2 {
+		t.Errorf("Expected 2 refunds and got %+v", refunds)
+	}
+}
@@ -0,0 +1,145 @@
+package mercadopago
+
+import (
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Header used by the MP API to deduplicate mutating requests
+const idempotencyHeader string = "X-Idempotency-Key"
+
+// RetryPolicy defines how failed API calls are retried.
+// GET requests are always retryable, while POST and PUT requests are only retried
+// when they carry an idempotency key.
+type RetryPolicy struct {
+	MaxAttempts int           // Total attempts, including the first one. Values lower than 2 disable retries
+	MinBackoff  time.Duration // Delay before the first retry, doubled on every following one
+	MaxBackoff  time.Duration // Upper limit for the delay between attempts, longer Retry-After requests are not retried
+	OnAttempt   func(Attempt) // Optional hook called after every attempt
+}
+
+// Attempt describes the outcome of a single try of an API call
+type Attempt struct {
+	Number   int            // Attempt number, starting at 1
+	Request  *http.Request  // Request sent to the API
+	Response *http.Response // Response received, nil on transport errors
+	Err      error          // Transport error, if any
+	Retry    bool           // Whether the call will be retried
+	Delay    time.Duration  // Wait before the next attempt
+}
+
+// DefaultRetryPolicy is a sensible policy for production usage
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  250 * time.Millisecond,
+	MaxBackoff:  5 * time.Second,
+}
+
+// WithRetryPolicy sets the retry policy applied to every API call
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(mp *MP) {
+		mp.retryPolicy = policy
+	}
+}
+
+// do sends the request, retrying it according to the configured policy
+func (mp *MP) do(r *http.Request) (*http.Response, error) {
+	policy := mp.retryPolicy
+	retryable := isRetryableRequest(r)
+	for attempt := 1; ; attempt++ {
+		req := r
+		if attempt > 1 {
+			req = r.Clone(r.Context())
+			if r.GetBody != nil {
+				body, err := r.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				req.Body = body
+			}
+		}
+		resp, err := mp.client().Do(req)
+		retry := retryable && attempt < policy.MaxAttempts && shouldRetry(resp, err) && r.Context().Err() == nil
+		var delay time.Duration
+		if retry {
+			if delay, retry = policy.backoff(attempt, resp); !retry {
+				delay = 0
+			}
+		}
+		if policy.OnAttempt != nil {
+			policy.OnAttempt(Attempt{Number: attempt, Request: req, Response: resp, Err: err, Retry: retry, Delay: delay})
+		}
+		if !retry {
+			return resp, err
+		}
+		if resp != nil {
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-r.Context().Done():
+			timer.Stop()
+			return nil, r.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// isRetryableRequest reports whether sending the request again is safe
+func isRetryableRequest(r *http.Request) bool {
+	switch r.Method {
+	case "GET", "HEAD", "OPTIONS":
+		return true
+	}
+	return r.Header.Get(idempotencyHeader) != ""
+}
+
+// shouldRetry reports whether the outcome of an attempt is a transient failure
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
+
+// backoff returns the wait before the next attempt, honoring the Retry-After header when present.
+// It reports false when the server asks to wait longer than MaxBackoff.
+func (p RetryPolicy) backoff(attempt int, resp *http.Response) (time.Duration, bool) {
+	if resp != nil {
+		if d, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return d, p.MaxBackoff <= 0 || d <= p.MaxBackoff
+		}
+	}
+	d := p.MinBackoff << uint(attempt-1)
+	if p.MaxBackoff > 0 && (d > p.MaxBackoff || d <= 0) {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0, true
+	}
+	// Equal jitter: keep half of the delay and randomize the rest
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1)), true
+}
+
+// retryAfter parses a Retry-After header value, either in seconds or as an HTTP date
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(value); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
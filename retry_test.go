@@ -0,0 +1,100 @@
+package mercadopago_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gpascual2/mp-sdk-go"
+)
+
+var testRetryPolicy = mercadopago.RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  time.Millisecond,
+	MaxBackoff:  5 * time.Millisecond,
+}
+
+// TestRetryGet - A GET call failing with a transient error should be retried
+func TestRetryGet(t *testing.T) {
+	fmt.Println("mp_test : RetryGet")
+	calls := 0
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"id":8262805,"status":"approved"}`)
+	})
+
+	attempts := 0
+	policy := testRetryPolicy
+	policy.OnAttempt = func(a mercadopago.Attempt) { attempts++ }
+	local := newFakeMP(server.URL, mercadopago.WithRetryPolicy(policy))
+	pmt, err := local.GetPayment("8262805")
+	if err != nil {
+		t.Fatalf("Error getting the payment: %v", err)
+	}
+	if pmt.Status != "approved" {
+		t.Errorf("Expected payment status to be approved and got %s", pmt.Status)
+	}
+	if calls != 3 || attempts != 3 {
+		t.Errorf("Expected 3 attempts and got %d calls / %d hook calls", calls, attempts)
+	}
+}
+
+// TestRetryPostWithoutIdempotencyKey - A POST call without idempotency key should not be retried
+func TestRetryPostWithoutIdempotencyKey(t *testing.T) {
+	fmt.Println("mp_test : RetryPostWithoutIdempotencyKey")
+	calls := 0
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	local := newFakeMP(server.URL, mercadopago.WithRetryPolicy(testRetryPolicy))
+	if _, err := local.CreatePayment(&mercadopago.Payment{}); err == nil {
+		t.Fatalf("Expected an error creating the payment")
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 call and got %d", calls)
+	}
+}
+
+// TestRetryAfter - The delay requested by the Retry-After header should be honored up to MaxBackoff
+func TestRetryAfter(t *testing.T) {
+	fmt.Println("mp_test : RetryAfter")
+	retryAfter := "1"
+	calls := 0
+	server := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", retryAfter)
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"id":8262805,"status":"approved"}`)
+	})
+
+	var delays []time.Duration
+	policy := testRetryPolicy
+	policy.MaxBackoff = 2 * time.Second
+	policy.OnAttempt = func(a mercadopago.Attempt) { delays = append(delays, a.Delay) }
+	local := newFakeMP(server.URL, mercadopago.WithRetryPolicy(policy))
+	start := time.Now()
+	if _, err := local.GetPayment("8262805"); err != nil {
+		t.Fatalf("Error getting the payment: %v", err)
+	}
+	if calls != 2 || delays[0] != time.Second || time.Since(start) < time.Second {
+		t.Errorf("Expected a retry after 1s and got %d calls with delays %v", calls, delays)
+	}
+
+	// A longer wait than MaxBackoff should not be retried
+	retryAfter, calls = "3600", 0
+	_, err := local.GetPayment("8262805")
+	if mpe, ok := err.(*mercadopago.MPError); !ok || mpe.Status != http.StatusTooManyRequests || calls != 1 {
+		t.Errorf("Expected a single call failing with status 429 and got %d calls and %v", calls, err)
+	}
+}
@@ -0,0 +1,109 @@
+package mercadopago
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidSignature is returned when a notification is not signed by MP with the expected secret
+var ErrInvalidSignature = errors.New("mercadopago: invalid notification signature")
+
+// VerifySignature checks the x-signature header of a notification sent by MP, computed with
+// HMAC-SHA256 over the notified resource ID, the x-request-id header and the timestamp.
+// The resource ID is the one dispatched by NotificationHandler, notifications whose data.id
+// query parameter does not match it are rejected.
+// The timestamp must be within tolerance of the current time, 0 disables this check.
+func VerifySignature(r *http.Request, secret string, tolerance time.Duration) error {
+	ts, v1 := parseSignatureHeader(r.Header.Get("X-Signature"))
+	if ts == "" || v1 == "" {
+		return fmt.Errorf("%w: missing or malformed x-signature header", ErrInvalidSignature)
+	}
+	if tolerance > 0 {
+		sent, err := parseSignatureTimestamp(ts)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+		}
+		if d := time.Since(sent); d > tolerance || d < -tolerance {
+			return fmt.Errorf("%w: timestamp %s out of tolerance", ErrInvalidSignature, ts)
+		}
+	}
+	// MP signs the data.id sent in the query string, which must be the notified resource
+	dataID := r.URL.Query().Get("data.id")
+	if n, err := ParseNotification(r); err == nil {
+		if dataID != "" && n.ResourceID != dataID {
+			return fmt.Errorf("%w: data.id %s does not match the notified resource %s", ErrInvalidSignature, dataID, n.ResourceID)
+		}
+		dataID = n.ResourceID
+	}
+	expected := signNotification(secret, dataID, r.Header.Get("X-Request-Id"), ts)
+	got, err := hex.DecodeString(v1)
+	if err != nil || !hmac.Equal(got, expected) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// SignatureMiddleware rejects with HTTP 401 the notifications whose signature is not valid,
+// passing the other ones to next (e.g. a NotificationHandler)
+func SignatureMiddleware(secret string, tolerance time.Duration, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := VerifySignature(r, secret, tolerance); err != nil {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// signNotification computes the HMAC of the manifest documented by MP:
+// "id:[data.id];request-id:[x-request-id];ts:[ts];", skipping the values not present
+func signNotification(secret string, dataID string, requestID string, ts string) []byte {
+	manifest := ""
+	if dataID != "" {
+		// Alphanumeric IDs are signed in lower case
+		manifest += "id:" + strings.ToLower(dataID) + ";"
+	}
+	if requestID != "" {
+		manifest += "request-id:" + requestID + ";"
+	}
+	manifest += "ts:" + ts + ";"
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(manifest))
+	return mac.Sum(nil)
+}
+
+// parseSignatureHeader returns the ts and v1 parts of a "ts=...,v1=..." header
+func parseSignatureHeader(header string) (ts string, v1 string) {
+	for _, part := range strings.Split(header, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "ts":
+			ts = kv[1]
+		case "v1":
+			v1 = kv[1]
+		}
+	}
+	return ts, v1
+}
+
+// parseSignatureTimestamp accepts timestamps in seconds or milliseconds
+func parseSignatureTimestamp(ts string) (time.Time, error) {
+	n, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp %s", ts)
+	}
+	if n > 1e12 {
+		return time.Unix(0, n*int64(time.Millisecond)), nil
+	}
+	return time.Unix(n, 0), nil
+}
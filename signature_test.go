@@ -0,0 +1,92 @@
+package mercadopago_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gpascual2/mp-sdk-go"
+)
+
+const testWebhookSecret = "webhook-secret"
+
+// signedNotification returns a notification request signed as MP does
+func signedNotification(secret string, ts int64) *http.Request {
+	r := httptest.NewRequest("POST", "/notifications?data.id=ABC123&type=payment", nil)
+	r.Header.Set("X-Request-Id", "bb56a2f1-6aae-46ac-982e-9dcd3581d08e")
+	manifest := "id:abc123;request-id:bb56a2f1-6aae-46ac-982e-9dcd3581d08e;ts:" + strconv.FormatInt(ts, 10) + ";"
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(manifest))
+	r.Header.Set("X-Signature", fmt.Sprintf("ts=%d,v1=%s", ts, hex.EncodeToString(mac.Sum(nil))))
+	return r
+}
+
+// TestVerifySignature - Notifications signed with the webhook secret should be accepted, others rejected
+func TestVerifySignature(t *testing.T) {
+	fmt.Println("mp_test : VerifySignature")
+	now := time.Now().Unix()
+	if err := mercadopago.VerifySignature(signedNotification(testWebhookSecret, now), testWebhookSecret, 5*time.Minute); err != nil {
+		t.Errorf("Expected a valid signature and got %v", err)
+	}
+	err := mercadopago.VerifySignature(signedNotification("other-secret", now), testWebhookSecret, 5*time.Minute)
+	if !errors.Is(err, mercadopago.ErrInvalidSignature) {
+		t.Errorf("Expected ErrInvalidSignature for a wrong secret and got %v", err)
+	}
+	err = mercadopago.VerifySignature(signedNotification(testWebhookSecret, now-3600), testWebhookSecret, 5*time.Minute)
+	if !errors.Is(err, mercadopago.ErrInvalidSignature) {
+		t.Errorf("Expected ErrInvalidSignature for an old timestamp and got %v", err)
+	}
+}
+
+// TestSignatureMiddleware - Only signed notifications should reach the wrapped handler
+func TestSignatureMiddleware(t *testing.T) {
+	fmt.Println("mp_test : SignatureMiddleware")
+	called := 0
+	handler := mercadopago.SignatureMiddleware(testWebhookSecret, 5*time.Minute, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called++
+	}))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, signedNotification(testWebhookSecret, time.Now().UnixNano()/int64(time.Millisecond)))
+	if w.Code != http.StatusOK || called != 1 {
+		t.Errorf("Expected the signed notification to be handled and got status %d", w.Code)
+	}
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("POST", "/notifications?data.id=1&type=payment", nil))
+	if w.Code != http.StatusUnauthorized || called != 1 {
+		t.Errorf("Expected the unsigned notification to be rejected and got status %d", w.Code)
+	}
+}
+
+// TestSignatureResourceMismatch - A signature should not be valid for a body notifying another resource
+func TestSignatureResourceMismatch(t *testing.T) {
+	fmt.Println("mp_test : SignatureResourceMismatch")
+	called := 0
+	handler := mercadopago.SignatureMiddleware(testWebhookSecret, 5*time.Minute, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called++
+	}))
+	cases := map[string]int{
+		`{"type":"payment","data":{"id":"ABC123"}}`: http.StatusOK,
+		`{"type":"payment","data":{"id":"999"}}`:    http.StatusUnauthorized,
+	}
+	for body, code := range cases {
+		r := signedNotification(testWebhookSecret, time.Now().Unix())
+		r.Body = ioutil.NopCloser(strings.NewReader(body))
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		if w.Code != code {
+			t.Errorf("Expected status %d for %s and got %d", code, body, w.Code)
+		}
+	}
+	if called != 1 {
+		t.Errorf("Expected only the matching notification to be handled and got %d calls", called)
+	}
+}
@@ -0,0 +1,183 @@
+package mercadopago
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Access tokens are renewed this long before they actually expire
+const tokenExpiryMargin = time.Minute
+
+// Token is an MP API access token along with its lifecycle data
+type Token struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresAt    time.Time // Zero value means the token does not expire
+}
+
+// Valid reports whether the token is set and not about to expire
+func (t *Token) Valid() bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+	return t.ExpiresAt.IsZero() || time.Now().Add(tokenExpiryMargin).Before(t.ExpiresAt)
+}
+
+// TokenStore keeps access tokens so they can be shared between MP instances
+// (e.g. several processes using the same credentials)
+type TokenStore interface {
+	// GetToken returns the token stored for key, or nil if there is none
+	GetToken(ctx context.Context, key string) (*Token, error)
+	// SetToken stores the token for key
+	SetToken(ctx context.Context, key string, token *Token) error
+	// DeleteToken removes the token stored for key
+	DeleteToken(ctx context.Context, key string) error
+}
+
+// MemoryTokenStore is a TokenStore that keeps tokens in memory
+type MemoryTokenStore struct {
+	mu     sync.Mutex
+	tokens map[string]Token
+}
+
+// NewMemoryTokenStore returns an empty in memory token store
+func NewMemoryTokenStore() *MemoryTokenStore {
+	return &MemoryTokenStore{tokens: map[string]Token{}}
+}
+
+// GetToken returns the token stored for key, or nil if there is none
+func (s *MemoryTokenStore) GetToken(ctx context.Context, key string) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	token, ok := s.tokens[key]
+	if !ok {
+		return nil, nil
+	}
+	return &token, nil
+}
+
+// SetToken stores the token for key
+func (s *MemoryTokenStore) SetToken(ctx context.Context, key string, token *Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.tokens == nil {
+		s.tokens = map[string]Token{}
+	}
+	s.tokens[key] = *token
+	return nil
+}
+
+// DeleteToken removes the token stored for key
+func (s *MemoryTokenStore) DeleteToken(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.tokens, key)
+	return nil
+}
+
+// WithTokenStore sets the store used to share access tokens, keyed by client ID
+func WithTokenStore(store TokenStore) Option {
+	return func(mp *MP) {
+		mp.tokenStore = store
+	}
+}
+
+// WithRefreshToken sets a refresh token (e.g. obtained through the authorization code flow),
+// used with the refresh_token grant to renew the access token
+func WithRefreshToken(refreshToken string) Option {
+	return func(mp *MP) {
+		mp.token = &Token{RefreshToken: refreshToken}
+	}
+}
+
+// SetAccessToken sets the access token of the Basic workflow (e.g. one obtained outside the SDK).
+// It is used until it is rejected by the API, then renewed as usual.
+func (mp *MP) SetAccessToken(accessToken string) {
+	mp.tokenMu.Lock()
+	defer mp.tokenMu.Unlock()
+	refreshToken := ""
+	if mp.token != nil {
+		refreshToken = mp.token.RefreshToken
+	}
+	mp.setToken(&Token{AccessToken: accessToken, RefreshToken: refreshToken})
+}
+
+// basicAccessToken returns a valid access token for the Basic workflow, renewing it when needed.
+// The lock is held while renewing, so concurrent callers wait for a single request to the API.
+func (mp *MP) basicAccessToken(ctx context.Context) (string, error) {
+	mp.tokenMu.Lock()
+	defer mp.tokenMu.Unlock()
+	// A token set by hand is used as is
+	if mp.BasicAccessToken != "" && (mp.token == nil || mp.token.AccessToken != mp.BasicAccessToken) {
+		refreshToken := ""
+		if mp.token != nil {
+			refreshToken = mp.token.RefreshToken
+		}
+		mp.token = &Token{AccessToken: mp.BasicAccessToken, RefreshToken: refreshToken}
+	}
+	if mp.token.Valid() {
+		return mp.token.AccessToken, nil
+	}
+	if mp.tokenStore != nil {
+		stored, err := mp.tokenStore.GetToken(ctx, mp.ClientID)
+		if err != nil {
+			return "", err
+		}
+		if stored.Valid() {
+			mp.setToken(stored)
+			return stored.AccessToken, nil
+		}
+		if mp.token == nil && stored != nil {
+			mp.token = stored
+		}
+	}
+	token, err := mp.renewAccessToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	if mp.tokenStore != nil {
+		if err := mp.tokenStore.SetToken(ctx, mp.ClientID, token); err != nil {
+			return "", err
+		}
+	}
+	mp.setToken(token)
+	return token.AccessToken, nil
+}
+
+// renewAccessToken obtains a new token, using the refresh token when there is one
+func (mp *MP) renewAccessToken(ctx context.Context) (*Token, error) {
+	if mp.token != nil && mp.token.RefreshToken != "" {
+		token, err := mp.obtainAccessToken(ctx, "refresh_token", mp.token.RefreshToken)
+		if err == nil {
+			return token, nil
+		}
+		// The refresh token may have been revoked, fall back to the client credentials
+		if mp.clientSecret == "" {
+			return nil, err
+		}
+	}
+	return mp.obtainAccessToken(ctx, "client_credentials", "")
+}
+
+// invalidateAccessToken discards the token if it is still the current one, forcing its renewal
+func (mp *MP) invalidateAccessToken(ctx context.Context, accessToken string) {
+	mp.tokenMu.Lock()
+	defer mp.tokenMu.Unlock()
+	if mp.token == nil || mp.token.AccessToken != accessToken {
+		return
+	}
+	mp.token = &Token{RefreshToken: mp.token.RefreshToken}
+	mp.BasicAccessToken = ""
+	if mp.tokenStore != nil {
+		if stored, err := mp.tokenStore.GetToken(ctx, mp.ClientID); err == nil && stored != nil && stored.AccessToken == accessToken {
+			mp.tokenStore.DeleteToken(ctx, mp.ClientID)
+		}
+	}
+}
+
+// setToken sets the current token, tokenMu must be held
+func (mp *MP) setToken(token *Token) {
+	mp.token = token
+	mp.BasicAccessToken = token.AccessToken
+}
@@ -0,0 +1,99 @@
+package mercadopago_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gpascual2/mp-sdk-go"
+)
+
+// newTokenServer returns a fake MP API issuing numbered tokens, which only accepts the last one issued
+func newTokenServer(expiresIn int, grants *[]string) *httptest.Server {
+	issued := 0
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/oauth/token" {
+			r.ParseForm()
+			*grants = append(*grants, r.PostForm.Get("grant_type"))
+			issued++
+			fmt.Fprintf(w, `{"access_token":"token-%d","refresh_token":"refresh-%d","expires_in":%d}`, issued, issued, expiresIn)
+			return
+		}
+		if r.Header.Get("Authorization") != fmt.Sprintf("Bearer token-%d", issued) {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"message":"invalid_token","error":"unauthorized","status":401}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":"pref-1"}`)
+	}))
+}
+
+// TestTokenExpiry - An expired access token should be renewed using the refresh token
+func TestTokenExpiry(t *testing.T) {
+	fmt.Println("mp_test : TokenExpiry")
+	var grants []string
+	server := newTokenServer(30, &grants)
+	defer server.Close()
+
+	local := newFakeMP(server.URL)
+	for i := 0; i < 2; i++ {
+		if _, err := local.GetPreference("pref-1"); err != nil {
+			t.Fatalf("Error getting the checkout preference: %v", err)
+		}
+	}
+	// Tokens expiring in 30 seconds are always within the renewal margin
+	if len(grants) != 2 || grants[0] != "client_credentials" || grants[1] != "refresh_token" {
+		t.Errorf("Expected a client_credentials grant followed by a refresh_token one and got %v", grants)
+	}
+}
+
+// TestTokenUnauthorized - A rejected access token should be renewed and the call retried once
+func TestTokenUnauthorized(t *testing.T) {
+	fmt.Println("mp_test : TokenUnauthorized")
+	var grants []string
+	server := newTokenServer(21600, &grants)
+	defer server.Close()
+
+	local := newFakeMP(server.URL)
+	local.SetAccessToken("revoked")
+	pref, err := local.GetPreference("pref-1")
+	if err != nil {
+		t.Fatalf("Error getting the checkout preference: %v", err)
+	}
+	if pref.ID != "pref-1" {
+		t.Errorf("Expected preference pref-1 and got %s", pref.ID)
+	}
+	if at, _ := local.GetAccessToken(); at != "token-1" {
+		t.Errorf("Expected AccessToken to be token-1 and got %s", at)
+	}
+}
+
+// TestTokenStore - Instances sharing a token store should reuse the same access token
+func TestTokenStore(t *testing.T) {
+	fmt.Println("mp_test : TokenStore")
+	var grants []string
+	server := newTokenServer(21600, &grants)
+	defer server.Close()
+
+	store := mercadopago.NewMemoryTokenStore()
+	first := newFakeMP(server.URL, mercadopago.WithTokenStore(store))
+	second := newFakeMP(server.URL, mercadopago.WithTokenStore(store))
+	if _, err := first.GetAccessToken(); err != nil {
+		t.Fatalf("Error requesting an Access Token: %v", err)
+	}
+	at, err := second.GetAccessToken()
+	if err != nil {
+		t.Fatalf("Error requesting an Access Token: %v", err)
+	}
+	if at != "token-1" || len(grants) != 1 {
+		t.Errorf("Expected token-1 from a single grant and got %s from %v", at, grants)
+	}
+	stored, _ := store.GetToken(context.Background(), "id")
+	if stored == nil || stored.ExpiresAt.Before(time.Now()) {
+		t.Errorf("Expected a stored token with its expiration and got %v", stored)
+	}
+}